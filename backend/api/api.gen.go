@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
@@ -15,14 +16,164 @@ import (
 	strictecho "github.com/oapi-codegen/runtime/strictmiddleware/echo"
 )
 
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for RevisionActorKind.
+const (
+	RevisionActorKindServiceAccount RevisionActorKind = "service_account"
+	RevisionActorKindUser           RevisionActorKind = "user"
+)
+
+// Defines values for Role.
+const (
+	Commenter Role = "commenter"
+	Editor    Role = "editor"
+	Owner     Role = "owner"
+	Viewer    Role = "viewer"
+)
+
+// Defines values for Scope.
+const (
+	TodosComment   Scope = "todos:comment"
+	TodosRead      Scope = "todos:read"
+	TodosWrite     Scope = "todos:write"
+	WorkspaceAdmin Scope = "workspace:admin"
+)
+
+// Defines values for TodoEventType.
+const (
+	TodoEventTypeCreated  TodoEventType = "created"
+	TodoEventTypeDeleted  TodoEventType = "deleted"
+	TodoEventTypeRestored TodoEventType = "restored"
+	TodoEventTypeUpdated  TodoEventType = "updated"
+)
+
+// Defines values for TodoOperationOp.
+const (
+	Create TodoOperationOp = "create"
+	Delete TodoOperationOp = "delete"
+	Update TodoOperationOp = "update"
+)
+
+// Defines values for TodoRevisionAction.
+const (
+	TodoRevisionActionCreated  TodoRevisionAction = "created"
+	TodoRevisionActionDeleted  TodoRevisionAction = "deleted"
+	TodoRevisionActionRestored TodoRevisionAction = "restored"
+	TodoRevisionActionUpdated  TodoRevisionAction = "updated"
+)
+
+// Defines values for ExportTodosParamsFormat.
+const (
+	ExportTodosParamsFormatCsv      ExportTodosParamsFormat = "csv"
+	ExportTodosParamsFormatJsonl    ExportTodosParamsFormat = "jsonl"
+	ExportTodosParamsFormatMarkdown ExportTodosParamsFormat = "markdown"
+)
+
+// Defines values for ImportTodosParamsFormat.
+const (
+	ImportTodosParamsFormatCsv      ImportTodosParamsFormat = "csv"
+	ImportTodosParamsFormatJsonl    ImportTodosParamsFormat = "jsonl"
+	ImportTodosParamsFormatMarkdown ImportTodosParamsFormat = "markdown"
+)
+
+// Defines values for ListTodosParamsSort.
+const (
+	CreatedAt ListTodosParamsSort = "created_at"
+	Title     ListTodosParamsSort = "title"
+	UpdatedAt ListTodosParamsSort = "updated_at"
+)
+
+// Defines values for ListTodosParamsOrder.
+const (
+	ListTodosParamsOrderAsc  ListTodosParamsOrder = "asc"
+	ListTodosParamsOrderDesc ListTodosParamsOrder = "desc"
+)
+
 // Defines values for ListTodosParamsSortUpdatedAt.
 const (
-	Asc  ListTodosParamsSortUpdatedAt = "asc"
-	Desc ListTodosParamsSortUpdatedAt = "desc"
+	ListTodosParamsSortUpdatedAtAsc  ListTodosParamsSortUpdatedAt = "asc"
+	ListTodosParamsSortUpdatedAtDesc ListTodosParamsSortUpdatedAt = "desc"
 )
 
+// AccessToken defines model for AccessToken.
+type AccessToken struct {
+	// AccessToken The bearer token to send in the Authorization header.
+	AccessToken string `json:"access_token"`
+
+	// ExpiresAt The time after which the token is no longer accepted.
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// TokenType The type of the token, always 'Bearer'.
+	TokenType string `json:"token_type"`
+}
+
+// ApiKey defines model for ApiKey.
+type ApiKey struct {
+	// CreatedAt The time that the key was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Id A unique identifier for this API key.
+	Id string `json:"id"`
+
+	// Name The name of the key.
+	Name string `json:"name"`
+
+	// Secret The secret to send as the bearer credential, only returned when the key is created.
+	Secret *string `json:"secret,omitempty"`
+}
+
+// ApiKeyList defines model for ApiKeyList.
+type ApiKeyList struct {
+	Items []ApiKey `json:"items"`
+}
+
+// CreateApiKey defines model for CreateApiKey.
+type CreateApiKey struct {
+	// Name A name to recognise the key by.
+	Name string `json:"name"`
+}
+
+// CreateGroup defines model for CreateGroup.
+type CreateGroup struct {
+	// DisplayName The human readable name of the group.
+	DisplayName *string `json:"display_name,omitempty"`
+
+	// Id The id of the group, used as the prefix of the TODO ids in the group.
+	Id string `json:"id"`
+
+	// NextSerial The serial number to assign to the next TODO created in the group.
+	NextSerial *int64 `json:"next_serial,omitempty"`
+}
+
+// CreateServiceAccount defines model for CreateServiceAccount.
+type CreateServiceAccount struct {
+	// DisplayName A display name for the service account.
+	DisplayName string `json:"display_name"`
+}
+
+// CreateServiceAccountToken defines model for CreateServiceAccountToken.
+type CreateServiceAccountToken struct {
+	// ExpiresAt The time that the token expires, which defaults to 90 days from now.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// GroupIds Limits the token to requests that only affect these groups. Requests that may affect any group, such as listing todos without a group filter, are then rejected.
+	GroupIds *[]string `json:"group_ids,omitempty"`
+
+	// Name A name to recognise the token by.
+	Name string `json:"name"`
+
+	// Scopes The scopes granted to the token.
+	Scopes []Scope `json:"scopes"`
+}
+
 // CreateTodo defines model for CreateTodo.
 type CreateTodo struct {
+	// Assignee The id of the user to assign the TODO to, who must be a member of the workspace.
+	Assignee *string `json:"assignee,omitempty"`
+
 	// Details The longer rich text content of the TODO item.
 	Details *string `json:"details,omitempty"`
 
@@ -31,8 +182,80 @@ type CreateTodo struct {
 
 	// Title The title of the TODO item.
 	Title string `json:"title"`
+
+	// Watchers The ids of the users watching the TODO, who must be members of the workspace.
+	Watchers *[]string `json:"watchers,omitempty"`
+}
+
+// CreateUser defines model for CreateUser.
+type CreateUser struct {
+	// DisplayName The human readable name of the user.
+	DisplayName string `json:"display_name"`
+
+	// Id The id of the new user. A random id is generated if this is not set.
+	Id *string `json:"id,omitempty"`
+}
+
+// CreateWorkspace defines model for CreateWorkspace.
+type CreateWorkspace struct {
+	// DisplayName The human readable name of the workspace.
+	DisplayName string `json:"display_name"`
+
+	// Id The id of the new workspace. A random id is generated if this is not set.
+	Id *string `json:"id,omitempty"`
+}
+
+// CreatedUser defines model for CreatedUser.
+type CreatedUser struct {
+	ApiKey ApiKey `json:"api_key"`
+	User   User   `json:"user"`
+}
+
+// ExchangeIdToken defines model for ExchangeIdToken.
+type ExchangeIdToken struct {
+	// IdToken An id token issued to the frontend by the OpenID Connect provider.
+	IdToken string `json:"id_token"`
+}
+
+// Group defines model for Group.
+type Group struct {
+	// DisplayName The human readable name of the group.
+	DisplayName *string       `json:"display_name,omitempty"`
+	Metadata    GroupMetadata `json:"metadata"`
+
+	// NextSerial The serial number that will be assigned to the next TODO created in the group.
+	NextSerial int64 `json:"next_serial"`
+
+	// TodoCount The number of TODOs currently in the group.
+	TodoCount int `json:"todo_count"`
+}
+
+// GroupList defines model for GroupList.
+type GroupList struct {
+	Items []Group `json:"items"`
+}
+
+// GroupMetadata defines model for GroupMetadata.
+type GroupMetadata struct {
+	// CreatedAt The time that the group was first created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Epoch A unique epoch for this group. This changes when a group is deleted and recreated with the same id.
+	Epoch int `json:"epoch"`
+
+	// Id A unique identifier for this group within the workspace.
+	Id string `json:"id"`
+
+	// WorkspaceEpoch The epoch of the workspace this group is tied to.
+	WorkspaceEpoch int `json:"workspace_epoch"`
+
+	// WorkspaceId The workspace this group exists in
+	WorkspaceId string `json:"workspace_id"`
 }
 
+// GroupRoles Roles of the member in individual groups, keyed by group id. A group role only applies when it allows more than the workspace role of the member.
+type GroupRoles map[string]Role
+
 // HealthZ defines model for HealthZ.
 type HealthZ = map[string]interface{}
 
@@ -54,8 +277,111 @@ type Problem struct {
 	Type string `json:"type"`
 }
 
+// ReplaceTodo defines model for ReplaceTodo.
+type ReplaceTodo struct {
+	// Assignee The id of the user to assign the TODO to, the TODO is unassigned if this is omitted.
+	Assignee *string `json:"assignee,omitempty"`
+
+	// Details The new longer rich text content of the TODO item, the details are cleared if this is omitted.
+	Details *string `json:"details,omitempty"`
+
+	// Revision The current revision of the TODO item. The replace is rejected if this does not match.
+	Revision int `json:"revision"`
+
+	// Status The new status of the TODO item.
+	Status string `json:"status"`
+
+	// Title The new title of the TODO item.
+	Title string `json:"title"`
+
+	// Watchers The ids of the users watching the TODO, who must be members of the workspace.
+	Watchers *[]string `json:"watchers,omitempty"`
+}
+
+// RevisionActor The user or service account that made a revision, absent if it was made anonymously.
+type RevisionActor struct {
+	// Id The id of the user or service account.
+	Id string `json:"id"`
+
+	// Kind The kind of principal that made the revision.
+	Kind RevisionActorKind `json:"kind"`
+}
+
+// RevisionActorKind The kind of principal that made the revision.
+type RevisionActorKind string
+
+// Role The role of a member, each role is allowed everything that the roles before it are allowed. A viewer may read the workspace, a commenter may also comment once comments are supported, an editor may change the todos and groups, and an owner may also change the workflow and members and delete the workspace.
+type Role string
+
+// Scope A permission that an operation requires, see the bearerAuth security scheme.
+type Scope string
+
+// ServiceAccount defines model for ServiceAccount.
+type ServiceAccount struct {
+	// CreatedAt The time that the service account was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// DisplayName A display name for the service account.
+	DisplayName string `json:"display_name"`
+
+	// Id A unique identifier for this service account.
+	Id string `json:"id"`
+}
+
+// ServiceAccountList defines model for ServiceAccountList.
+type ServiceAccountList struct {
+	Items []ServiceAccount `json:"items"`
+}
+
+// ServiceAccountToken defines model for ServiceAccountToken.
+type ServiceAccountToken struct {
+	// CreatedAt The time that the token was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// ExpiresAt The time that the token expires.
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// GroupIds The groups that the token is limited to, if any.
+	GroupIds *[]string `json:"group_ids,omitempty"`
+
+	// Id A unique identifier for this token.
+	Id string `json:"id"`
+
+	// LastUsedAt The time that the token was last used, if it has been used.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+
+	// Name The name of the token.
+	Name string `json:"name"`
+
+	// RevokedAt The time that the token was revoked, if it has been revoked.
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+
+	// Scopes The scopes granted to the token.
+	Scopes []Scope `json:"scopes"`
+
+	// Secret The secret to send as the bearer credential, only returned when the token is created.
+	Secret *string `json:"secret,omitempty"`
+}
+
+// ServiceAccountTokenList defines model for ServiceAccountTokenList.
+type ServiceAccountTokenList struct {
+	Items []ServiceAccountToken `json:"items"`
+}
+
+// SetWorkspaceMember defines model for SetWorkspaceMember.
+type SetWorkspaceMember struct {
+	// GroupRoles Roles of the member in individual groups, keyed by group id. A group role only applies when it allows more than the workspace role of the member.
+	GroupRoles *GroupRoles `json:"group_roles,omitempty"`
+
+	// Role The role of a member, each role is allowed everything that the roles before it are allowed. A viewer may read the workspace, a commenter may also comment once comments are supported, an editor may change the todos and groups, and an owner may also change the workflow and members and delete the workspace.
+	Role Role `json:"role"`
+}
+
 // Todo defines model for Todo.
 type Todo struct {
+	// Assignee The id of the user the TODO item is assigned to, if any.
+	Assignee *string `json:"assignee,omitempty"`
+
 	// Details The longer rich text content of the TODO item.
 	Details  *string      `json:"details,omitempty"`
 	Metadata TodoMetadata `json:"metadata"`
@@ -65,6 +391,60 @@ type Todo struct {
 
 	// Title The title of the TODO item.
 	Title string `json:"title"`
+
+	// Watchers The ids of the users watching the TODO item, omitted if there are none.
+	Watchers *[]string `json:"watchers,omitempty"`
+}
+
+// TodoBatch defines model for TodoBatch.
+type TodoBatch struct {
+	Operations []TodoOperation `json:"operations"`
+
+	// Partial Apply the operations that succeed even if others fail. An internal error still fails the whole batch without applying any of it.
+	Partial *bool `json:"partial,omitempty"`
+}
+
+// TodoBatchResults defines model for TodoBatchResults.
+type TodoBatchResults struct {
+	Results []TodoOperationResult `json:"results"`
+}
+
+// TodoEvent defines model for TodoEvent.
+type TodoEvent struct {
+	Todo Todo `json:"todo"`
+
+	// Type The change made to the TODO.
+	Type TodoEventType `json:"type"`
+}
+
+// TodoEventType The change made to the TODO.
+type TodoEventType string
+
+// TodoFieldChange defines model for TodoFieldChange.
+type TodoFieldChange struct {
+	// Field The name of the field that changed.
+	Field string `json:"field"`
+
+	// From The previous value, absent if the field was not set.
+	From *string `json:"from,omitempty"`
+
+	// To The new value, absent if the field is no longer set.
+	To *string `json:"to,omitempty"`
+}
+
+// TodoHistory defines model for TodoHistory.
+type TodoHistory struct {
+	// Items The revisions of the TODO, oldest first.
+	Items []TodoRevision `json:"items"`
+}
+
+// TodoImport defines model for TodoImport.
+type TodoImport struct {
+	// Imported The number of TODOs imported.
+	Imported int `json:"imported"`
+
+	// Renumbered The TODOs that could not keep their id because it was already taken.
+	Renumbered []TodoRenumbering `json:"renumbered"`
 }
 
 // TodoMetadata defines model for TodoMetadata.
@@ -72,9 +452,15 @@ type TodoMetadata struct {
 	// CreatedAt The time that the TODO item was first created.
 	CreatedAt time.Time `json:"created_at"`
 
+	// DeletedAt The time that the TODO item was moved to the trash, only set for TODOs in the trash.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+
 	// Epoch A unique epoch for this TODO item.
 	Epoch int `json:"epoch"`
 
+	// Etag The entity tag of the TODO item, the same as its ETag header, so that the items of a list can be used with If-Match.
+	Etag *string `json:"etag,omitempty"`
+
 	// GroupEpoch The epoch of the workspace this TODO item is tied to.
 	GroupEpoch int `json:"group_epoch"`
 
@@ -97,6 +483,34 @@ type TodoMetadata struct {
 	WorkspaceId string `json:"workspace_id"`
 }
 
+// TodoOperation defines model for TodoOperation.
+type TodoOperation struct {
+	Create *CreateTodo `json:"create,omitempty"`
+
+	// Op The kind of operation, which selects the field holding its content. A create has only the create field, an update has the todo_id and update fields, and a delete has the todo_id and revision fields.
+	Op TodoOperationOp `json:"op"`
+
+	// Revision The revision the TODO must be at to be deleted, the delete is rejected if this does not match.
+	Revision *int `json:"revision,omitempty"`
+
+	// TodoId The id of the TODO to update or delete.
+	TodoId *string     `json:"todo_id,omitempty"`
+	Update *UpdateTodo `json:"update,omitempty"`
+}
+
+// TodoOperationOp The kind of operation, which selects the field holding its content. A create has only the create field, an update has the todo_id and update fields, and a delete has the todo_id and revision fields.
+type TodoOperationOp string
+
+// TodoOperationResult defines model for TodoOperationResult.
+type TodoOperationResult struct {
+	// Problem An https://datatracker.ietf.org/doc/html/rfc9457 Problem response.
+	Problem *Problem `json:"problem,omitempty"`
+
+	// Status The http status of the operation as if it had been requested on its own.
+	Status int   `json:"status"`
+	Todo   *Todo `json:"todo,omitempty"`
+}
+
 // TodoPage defines model for TodoPage.
 type TodoPage struct {
 	Items          []Todo  `json:"items"`
@@ -104,613 +518,5279 @@ type TodoPage struct {
 	RemainingItems int     `json:"remaining_items"`
 }
 
-// StandardBadRequestProblem An https://datatracker.ietf.org/doc/html/rfc9457 Problem response.
-type StandardBadRequestProblem = Problem
+// TodoRenumbering defines model for TodoRenumbering.
+type TodoRenumbering struct {
+	// From The id of the TODO in the import.
+	From string `json:"from"`
 
-// StandardNotFoundProblem An https://datatracker.ietf.org/doc/html/rfc9457 Problem response.
-type StandardNotFoundProblem = Problem
+	// To The id the TODO was given instead.
+	To string `json:"to"`
+}
 
-// StandardProblemResponse An https://datatracker.ietf.org/doc/html/rfc9457 Problem response.
-type StandardProblemResponse = Problem
+// TodoRevision defines model for TodoRevision.
+type TodoRevision struct {
+	// Action The kind of change.
+	Action TodoRevisionAction `json:"action"`
 
-// ListTodosParams defines parameters for ListTodos.
-type ListTodosParams struct {
-	// Page The page token to request.
-	Page *string `form:"page,omitempty" json:"page,omitempty"`
+	// Actor The user or service account that made a revision, absent if it was made anonymously.
+	Actor *RevisionActor `json:"actor,omitempty"`
 
-	// PageSize The page size to limit the response to.
-	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
+	// Changes The fields that changed in this revision.
+	Changes []TodoFieldChange `json:"changes"`
 
-	// Status Filter by a status.
-	Status *string `form:"status,omitempty" json:"status,omitempty"`
+	// Revision The revision number of the TODO after this change.
+	Revision int `json:"revision"`
 
-	// SortUpdatedAt Sort by updated at
-	SortUpdatedAt *ListTodosParamsSortUpdatedAt `form:"sort_updated_at,omitempty" json:"sort_updated_at,omitempty"`
+	// UpdatedAt The time of the change.
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
-// ListTodosParamsSortUpdatedAt defines parameters for ListTodos.
-type ListTodosParamsSortUpdatedAt string
+// TodoRevisionAction The kind of change.
+type TodoRevisionAction string
 
-// CreateTodoJSONRequestBody defines body for CreateTodo for application/json ContentType.
-type CreateTodoJSONRequestBody = CreateTodo
+// UpdateGroup defines model for UpdateGroup.
+type UpdateGroup struct {
+	// DisplayName The new human readable name of the group.
+	DisplayName *string `json:"display_name,omitempty"`
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Get the health status of the TODOs application
-	// (GET /healthz)
-	GetHealthZ(ctx echo.Context) error
-	// List TODOs in the current workspace.
-	// (GET /workspace/{workspaceId}/todos)
-	ListTodos(ctx echo.Context, workspaceId string, params ListTodosParams) error
-	// Create a new TODO in the workspace.
-	// (POST /workspace/{workspaceId}/todos)
-	CreateTodo(ctx echo.Context, workspaceId string) error
-	// Delete a TODO item by id.
-	// (DELETE /workspace/{workspaceId}/todos/{todoId})
-	DeleteTodo(ctx echo.Context, workspaceId string, todoId string) error
-	// Get a TODO item by id.
-	// (GET /workspace/{workspaceId}/todos/{todoId})
-	GetTodo(ctx echo.Context, workspaceId string, todoId string) error
+	// NextSerial The serial number to assign to the next TODO created in the group. This can only move forward.
+	NextSerial *int64 `json:"next_serial,omitempty"`
 }
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
+// UpdateTodo defines model for UpdateTodo.
+type UpdateTodo struct {
+	// Assignee The id of the user to assign the TODO to, or an empty string to unassign it.
+	Assignee *string `json:"assignee,omitempty"`
+
+	// Details The new longer rich text content of the TODO item, an empty string clears the details.
+	Details *string `json:"details,omitempty"`
+
+	// Revision The current revision of the TODO item. The update is rejected if this does not match.
+	Revision int `json:"revision"`
+
+	// Status The new status of the TODO item.
+	Status *string `json:"status,omitempty"`
+
+	// Title The new title of the TODO item.
+	Title *string `json:"title,omitempty"`
+
+	// Watchers Replaces the ids of the users watching the TODO.
+	Watchers *[]string `json:"watchers,omitempty"`
 }
 
-// GetHealthZ converts echo context to params.
-func (w *ServerInterfaceWrapper) GetHealthZ(ctx echo.Context) error {
-	var err error
+// User defines model for User.
+type User struct {
+	// CreatedAt The time that the user was created.
+	CreatedAt time.Time `json:"created_at"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetHealthZ(ctx)
-	return err
+	// DisplayName The human readable name of the user.
+	DisplayName string `json:"display_name"`
+
+	// Id A unique identifier for this user.
+	Id string `json:"id"`
 }
 
-// ListTodos converts echo context to params.
-func (w *ServerInterfaceWrapper) ListTodos(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "workspaceId" -------------
-	var workspaceId string
+// Workflow defines model for Workflow.
+type Workflow struct {
+	// InitialStatus The status assigned to newly created TODO items.
+	InitialStatus string `json:"initial_status"`
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
-	}
+	// Statuses The statuses that TODO items in the workspace may have, in order of progress. The last status is the terminal status of a finished TODO item, unless it is also the initial status.
+	Statuses []string `json:"statuses"`
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListTodosParams
-	// ------------- Optional query parameter "page" -------------
+	// Transitions The status changes that are allowed. A TODO item may always be updated without changing its status.
+	Transitions []WorkflowTransition `json:"transitions"`
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
-	}
+// WorkflowTransition defines model for WorkflowTransition.
+type WorkflowTransition struct {
+	// From The status being moved from.
+	From string `json:"from"`
 
-	// ------------- Optional query parameter "page_size" -------------
+	// To The statuses that may be moved to.
+	To []string `json:"to"`
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "page_size", ctx.QueryParams(), &params.PageSize)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page_size: %s", err))
-	}
+// Workspace defines model for Workspace.
+type Workspace struct {
+	// DisplayName The human readable name of the workspace.
+	DisplayName string            `json:"display_name"`
+	Metadata    WorkspaceMetadata `json:"metadata"`
+}
 
-	// ------------- Optional query parameter "status" -------------
+// WorkspaceMember defines model for WorkspaceMember.
+type WorkspaceMember struct {
+	// AddedAt The time that the user was added to the workspace.
+	AddedAt time.Time `json:"added_at"`
 
-	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
-	}
+	// GroupRoles Roles of the member in individual groups, keyed by group id. A group role only applies when it allows more than the workspace role of the member.
+	GroupRoles *GroupRoles `json:"group_roles,omitempty"`
 
-	// ------------- Optional query parameter "sort_updated_at" -------------
+	// Role The role of a member, each role is allowed everything that the roles before it are allowed. A viewer may read the workspace, a commenter may also comment once comments are supported, an editor may change the todos and groups, and an owner may also change the workflow and members and delete the workspace.
+	Role Role `json:"role"`
 
-	err = runtime.BindQueryParameter("form", true, false, "sort_updated_at", ctx.QueryParams(), &params.SortUpdatedAt)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort_updated_at: %s", err))
-	}
+	// UserId The id of the member.
+	UserId string `json:"user_id"`
+}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListTodos(ctx, workspaceId, params)
-	return err
+// WorkspaceMemberList defines model for WorkspaceMemberList.
+type WorkspaceMemberList struct {
+	Items []WorkspaceMember `json:"items"`
 }
 
-// CreateTodo converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateTodo(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "workspaceId" -------------
-	var workspaceId string
+// WorkspaceMetadata defines model for WorkspaceMetadata.
+type WorkspaceMetadata struct {
+	// CreatedAt The time that the workspace was first created.
+	CreatedAt time.Time `json:"created_at"`
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
-	}
+	// Epoch A unique epoch for this workspace. This changes when a workspace is deleted and recreated with the same id.
+	Epoch int `json:"epoch"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateTodo(ctx, workspaceId)
-	return err
+	// Id A unique identifier for this workspace.
+	Id string `json:"id"`
 }
 
-// DeleteTodo converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteTodo(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "workspaceId" -------------
-	var workspaceId string
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
-	}
+// WorkspacePage defines model for WorkspacePage.
+type WorkspacePage struct {
+	Items          []Workspace `json:"items"`
+	NextPageToken  *string     `json:"next_page_token,omitempty"`
+	RemainingItems int         `json:"remaining_items"`
+}
 
-	// ------------- Path parameter "todoId" -------------
-	var todoId string
+// StandardBadRequestProblem An https://datatracker.ietf.org/doc/html/rfc9457 Problem response.
+type StandardBadRequestProblem = Problem
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "todoId", runtime.ParamLocationPath, ctx.Param("todoId"), &todoId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
-	}
+// StandardConflictProblem An https://datatracker.ietf.org/doc/html/rfc9457 Problem response.
+type StandardConflictProblem = Problem
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteTodo(ctx, workspaceId, todoId)
-	return err
-}
+// StandardForbiddenProblem An https://datatracker.ietf.org/doc/html/rfc9457 Problem response.
+type StandardForbiddenProblem = Problem
 
-// GetTodo converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTodo(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "workspaceId" -------------
-	var workspaceId string
+// StandardNotFoundProblem An https://datatracker.ietf.org/doc/html/rfc9457 Problem response.
+type StandardNotFoundProblem = Problem
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
-	}
+// StandardPreconditionFailedProblem An https://datatracker.ietf.org/doc/html/rfc9457 Problem response.
+type StandardPreconditionFailedProblem = Problem
 
-	// ------------- Path parameter "todoId" -------------
-	var todoId string
+// StandardProblemResponse An https://datatracker.ietf.org/doc/html/rfc9457 Problem response.
+type StandardProblemResponse = Problem
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "todoId", runtime.ParamLocationPath, ctx.Param("todoId"), &todoId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
-	}
+// StandardUnauthorizedProblem An https://datatracker.ietf.org/doc/html/rfc9457 Problem response.
+type StandardUnauthorizedProblem = Problem
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTodo(ctx, workspaceId, todoId)
-	return err
+// StreamTodoEventsParams defines parameters for StreamTodoEvents.
+type StreamTodoEventsParams struct {
+	// LastEventID The id of the last event received, to resume the stream after it.
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
 }
 
-// This is a simple interface which specifies echo.Route addition functions which
-// are present on both echo.Echo and echo.Group, since we want to allow using
-// either of them for path registration
-type EchoRouter interface {
-	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+// ExportTodosParams defines parameters for ExportTodos.
+type ExportTodosParams struct {
+	// Format The format of the export.
+	Format *ExportTodosParamsFormat `form:"format,omitempty" json:"format,omitempty"`
 }
 
-// RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
-	RegisterHandlersWithBaseURL(router, si, "")
+// ExportTodosParamsFormat defines parameters for ExportTodos.
+type ExportTodosParamsFormat string
+
+// ImportTodosParams defines parameters for ImportTodos.
+type ImportTodosParams struct {
+	// Format The format of the import.
+	Format *ImportTodosParamsFormat `form:"format,omitempty" json:"format,omitempty"`
 }
 
-// Registers handlers, and prepends BaseURL to the paths, so that the paths
-// can be served under a prefix.
-func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+// ImportTodosParamsFormat defines parameters for ImportTodos.
+type ImportTodosParamsFormat string
 
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
-	}
+// ListTodosParams defines parameters for ListTodos.
+type ListTodosParams struct {
+	// Page The page token to request. The page token keeps the filters of the first page, so the filters may be omitted on the following pages but must not be changed.
+	Page *string `form:"page,omitempty" json:"page,omitempty"`
 
-	router.GET(baseURL+"/healthz", wrapper.GetHealthZ)
-	router.GET(baseURL+"/workspace/:workspaceId/todos", wrapper.ListTodos)
-	router.POST(baseURL+"/workspace/:workspaceId/todos", wrapper.CreateTodo)
-	router.DELETE(baseURL+"/workspace/:workspaceId/todos/:todoId", wrapper.DeleteTodo)
-	router.GET(baseURL+"/workspace/:workspaceId/todos/:todoId", wrapper.GetTodo)
+	// PageSize The page size to limit the response to.
+	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
 
-}
+	// Status Filter by status, repeat the parameter to match any of several statuses.
+	Status *[]string `form:"status,omitempty" json:"status,omitempty"`
 
-type StandardBadRequestProblemJSONResponse Problem
+	// Group Filter by group id, repeat the parameter to match any of several groups.
+	Group *[]string `form:"group,omitempty" json:"group,omitempty"`
 
-type StandardNotFoundProblemJSONResponse Problem
+	// Assignee Filter by the id of the assigned user, 'me' for the TODOs assigned to the authenticated user, or 'none' for the unassigned TODOs.
+	Assignee *string `form:"assignee,omitempty" json:"assignee,omitempty"`
 
-type StandardProblemResponseJSONResponse Problem
+	// CreatedAfter Only list TODOs created at or after this time.
+	CreatedAfter *time.Time `form:"created_after,omitempty" json:"created_after,omitempty"`
 
-type GetHealthZRequestObject struct {
-}
+	// CreatedBefore Only list TODOs created before this time.
+	CreatedBefore *time.Time `form:"created_before,omitempty" json:"created_before,omitempty"`
 
-type GetHealthZResponseObject interface {
-	VisitGetHealthZResponse(w http.ResponseWriter) error
-}
+	// UpdatedAfter Only list TODOs last updated at or after this time.
+	UpdatedAfter *time.Time `form:"updated_after,omitempty" json:"updated_after,omitempty"`
 
-type GetHealthZ200JSONResponse HealthZ
+	// UpdatedBefore Only list TODOs last updated before this time.
+	UpdatedBefore *time.Time `form:"updated_before,omitempty" json:"updated_before,omitempty"`
 
-func (response GetHealthZ200JSONResponse) VisitGetHealthZResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// Q Search the title and details of the TODOs. Every word must match and the results are ordered by relevance rather than by id.
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Sort The field to sort by. By default TODOs are sorted by relevance when searching and then by group and id. Titles are compared case-insensitively, and TODOs with the same value are ordered by group and id. The page token keeps the sort of the first page.
+	Sort *ListTodosParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
 
-type GetHealthZdefaultJSONResponse struct {
-	Body       Problem
-	StatusCode int
+	// Order The direction of the sort, this requires a sort field.
+	Order *ListTodosParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// SortUpdatedAt Sort by updated at, this is the same as sort=updated_at with the given order.
+	SortUpdatedAt *ListTodosParamsSortUpdatedAt `form:"sort_updated_at,omitempty" json:"sort_updated_at,omitempty"`
 }
 
-func (response GetHealthZdefaultJSONResponse) VisitGetHealthZResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(response.StatusCode)
+// ListTodosParamsSort defines parameters for ListTodos.
+type ListTodosParamsSort string
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+// ListTodosParamsOrder defines parameters for ListTodos.
+type ListTodosParamsOrder string
 
-type ListTodosRequestObject struct {
-	WorkspaceId string `json:"workspaceId"`
-	Params      ListTodosParams
+// ListTodosParamsSortUpdatedAt defines parameters for ListTodos.
+type ListTodosParamsSortUpdatedAt string
+
+// DeleteTodoParams defines parameters for DeleteTodo.
+type DeleteTodoParams struct {
+	// IfMatch One or more ETags previously returned for the todo, or "*". The request only succeeds if the todo still has the epoch and revision of one of them, otherwise it fails with 412 Precondition Failed.
+	IfMatch *string `json:"If-Match,omitempty"`
 }
 
-type ListTodosResponseObject interface {
-	VisitListTodosResponse(w http.ResponseWriter) error
+// UpdateTodoParams defines parameters for UpdateTodo.
+type UpdateTodoParams struct {
+	// IfMatch One or more ETags previously returned for the todo, or "*". The request only succeeds if the todo still has the epoch and revision of one of them, otherwise it fails with 412 Precondition Failed.
+	IfMatch *string `json:"If-Match,omitempty"`
 }
 
-type ListTodos200JSONResponse TodoPage
+// ReplaceTodoParams defines parameters for ReplaceTodo.
+type ReplaceTodoParams struct {
+	// IfMatch One or more ETags previously returned for the todo, or "*". The request only succeeds if the todo still has the epoch and revision of one of them, otherwise it fails with 412 Precondition Failed.
+	IfMatch *string `json:"If-Match,omitempty"`
+}
 
-func (response ListTodos200JSONResponse) VisitListTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// ListTrashParams defines parameters for ListTrash.
+type ListTrashParams struct {
+	// Page The page token to request.
+	Page *string `form:"page,omitempty" json:"page,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	// PageSize The page size to limit the response to.
+	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
 }
 
-type ListTodos400JSONResponse struct {
-	StandardBadRequestProblemJSONResponse
+// ListWorkspacesParams defines parameters for ListWorkspaces.
+type ListWorkspacesParams struct {
+	// Page The page token to request.
+	Page *string `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize The page size to limit the response to.
+	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
 }
 
-func (response ListTodos400JSONResponse) VisitListTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// ExchangeIdTokenJSONRequestBody defines body for ExchangeIdToken for application/json ContentType.
+type ExchangeIdTokenJSONRequestBody = ExchangeIdToken
 
-	return json.NewEncoder(w).Encode(response)
-}
+// CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
+type CreateUserJSONRequestBody = CreateUser
 
-type ListTodos404JSONResponse struct {
-	StandardNotFoundProblemJSONResponse
-}
+// CreateApiKeyJSONRequestBody defines body for CreateApiKey for application/json ContentType.
+type CreateApiKeyJSONRequestBody = CreateApiKey
 
-func (response ListTodos404JSONResponse) VisitListTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// CreateGroupJSONRequestBody defines body for CreateGroup for application/json ContentType.
+type CreateGroupJSONRequestBody = CreateGroup
 
-	return json.NewEncoder(w).Encode(response)
+// UpdateGroupJSONRequestBody defines body for UpdateGroup for application/json ContentType.
+type UpdateGroupJSONRequestBody = UpdateGroup
+
+// SetWorkspaceMemberJSONRequestBody defines body for SetWorkspaceMember for application/json ContentType.
+type SetWorkspaceMemberJSONRequestBody = SetWorkspaceMember
+
+// CreateServiceAccountJSONRequestBody defines body for CreateServiceAccount for application/json ContentType.
+type CreateServiceAccountJSONRequestBody = CreateServiceAccount
+
+// CreateServiceAccountTokenJSONRequestBody defines body for CreateServiceAccountToken for application/json ContentType.
+type CreateServiceAccountTokenJSONRequestBody = CreateServiceAccountToken
+
+// CreateTodoJSONRequestBody defines body for CreateTodo for application/json ContentType.
+type CreateTodoJSONRequestBody = CreateTodo
+
+// UpdateTodoJSONRequestBody defines body for UpdateTodo for application/json ContentType.
+type UpdateTodoJSONRequestBody = UpdateTodo
+
+// ReplaceTodoJSONRequestBody defines body for ReplaceTodo for application/json ContentType.
+type ReplaceTodoJSONRequestBody = ReplaceTodo
+
+// BatchTodosJSONRequestBody defines body for BatchTodos for application/json ContentType.
+type BatchTodosJSONRequestBody = TodoBatch
+
+// SetWorkflowJSONRequestBody defines body for SetWorkflow for application/json ContentType.
+type SetWorkflowJSONRequestBody = Workflow
+
+// CreateWorkspaceJSONRequestBody defines body for CreateWorkspace for application/json ContentType.
+type CreateWorkspaceJSONRequestBody = CreateWorkspace
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Exchange an OpenID Connect id token for a short-lived bearer token.
+	// (POST /auth/oidc)
+	ExchangeIdToken(ctx echo.Context) error
+	// Exchange the credentials of the request for a short-lived bearer token.
+	// (POST /auth/token)
+	CreateToken(ctx echo.Context) error
+	// Get the health status of the TODOs application
+	// (GET /healthz)
+	GetHealthZ(ctx echo.Context) error
+	// Register a new user.
+	// (POST /users)
+	CreateUser(ctx echo.Context) error
+	// Get the authenticated user.
+	// (GET /users/me)
+	GetCurrentUser(ctx echo.Context) error
+	// List the API keys of the authenticated user.
+	// (GET /users/me/api-keys)
+	ListApiKeys(ctx echo.Context) error
+	// Create an API key for the authenticated user.
+	// (POST /users/me/api-keys)
+	CreateApiKey(ctx echo.Context) error
+	// Revoke an API key of the authenticated user.
+	// (DELETE /users/me/api-keys/{keyId})
+	DeleteApiKey(ctx echo.Context, keyId string) error
+	// Delete a workspace and all of its TODOs. The Public workspace cannot be deleted.
+	// (DELETE /workspace/{workspaceId})
+	DeleteWorkspace(ctx echo.Context, workspaceId string) error
+	// Get a workspace by id.
+	// (GET /workspace/{workspaceId})
+	GetWorkspace(ctx echo.Context, workspaceId string) error
+	// Stream the changes to the TODOs in the workspace.
+	// (GET /workspace/{workspaceId}/events)
+	StreamTodoEvents(ctx echo.Context, workspaceId string, params StreamTodoEventsParams) error
+	// Export every TODO in the workspace.
+	// (GET /workspace/{workspaceId}/export)
+	ExportTodos(ctx echo.Context, workspaceId string, params ExportTodosParams) error
+	// List the groups in the workspace along with their TODO counts.
+	// (GET /workspace/{workspaceId}/groups)
+	ListGroups(ctx echo.Context, workspaceId string) error
+	// Create a new group in the workspace.
+	// (POST /workspace/{workspaceId}/groups)
+	CreateGroup(ctx echo.Context, workspaceId string) error
+	// Delete a group and all of the TODOs in it.
+	// (DELETE /workspace/{workspaceId}/groups/{groupId})
+	DeleteGroup(ctx echo.Context, workspaceId string, groupId string) error
+	// Get a group by id.
+	// (GET /workspace/{workspaceId}/groups/{groupId})
+	GetGroup(ctx echo.Context, workspaceId string, groupId string) error
+	// Rename a group or move its id counter forward.
+	// (PATCH /workspace/{workspaceId}/groups/{groupId})
+	UpdateGroup(ctx echo.Context, workspaceId string, groupId string) error
+	// Import TODOs into the workspace.
+	// (POST /workspace/{workspaceId}/import)
+	ImportTodos(ctx echo.Context, workspaceId string, params ImportTodosParams) error
+	// List the members of the workspace.
+	// (GET /workspace/{workspaceId}/members)
+	ListWorkspaceMembers(ctx echo.Context, workspaceId string) error
+	// Remove a member from the workspace.
+	// (DELETE /workspace/{workspaceId}/members/{userId})
+	RemoveWorkspaceMember(ctx echo.Context, workspaceId string, userId string) error
+	// Add a user as a member of the workspace or change the roles of a member.
+	// (PUT /workspace/{workspaceId}/members/{userId})
+	SetWorkspaceMember(ctx echo.Context, workspaceId string, userId string) error
+	// List the service accounts of the workspace.
+	// (GET /workspace/{workspaceId}/service-accounts)
+	ListServiceAccounts(ctx echo.Context, workspaceId string) error
+	// Create a service account owned by the workspace.
+	// (POST /workspace/{workspaceId}/service-accounts)
+	CreateServiceAccount(ctx echo.Context, workspaceId string) error
+	// Delete a service account along with its tokens.
+	// (DELETE /workspace/{workspaceId}/service-accounts/{accountId})
+	DeleteServiceAccount(ctx echo.Context, workspaceId string, accountId string) error
+	// List the tokens of a service account, including the revoked and expired tokens.
+	// (GET /workspace/{workspaceId}/service-accounts/{accountId}/tokens)
+	ListServiceAccountTokens(ctx echo.Context, workspaceId string, accountId string) error
+	// Issue a token to a service account.
+	// (POST /workspace/{workspaceId}/service-accounts/{accountId}/tokens)
+	CreateServiceAccountToken(ctx echo.Context, workspaceId string, accountId string) error
+	// Revoke a token of a service account.
+	// (DELETE /workspace/{workspaceId}/service-accounts/{accountId}/tokens/{tokenId})
+	RevokeServiceAccountToken(ctx echo.Context, workspaceId string, accountId string, tokenId string) error
+	// List TODOs in the current workspace.
+	// (GET /workspace/{workspaceId}/todos)
+	ListTodos(ctx echo.Context, workspaceId string, params ListTodosParams) error
+	// Create a new TODO in the workspace.
+	// (POST /workspace/{workspaceId}/todos)
+	CreateTodo(ctx echo.Context, workspaceId string) error
+	// Delete a TODO item by id, moving it to the trash of the workspace.
+	// (DELETE /workspace/{workspaceId}/todos/{todoId})
+	DeleteTodo(ctx echo.Context, workspaceId string, todoId string, params DeleteTodoParams) error
+	// Get a TODO item by id.
+	// (GET /workspace/{workspaceId}/todos/{todoId})
+	GetTodo(ctx echo.Context, workspaceId string, todoId string) error
+	// Update the title, details, or status of a TODO item.
+	// (PATCH /workspace/{workspaceId}/todos/{todoId})
+	UpdateTodo(ctx echo.Context, workspaceId string, todoId string, params UpdateTodoParams) error
+	// Replace the title, details, status, assignee and watchers of a TODO item.
+	// (PUT /workspace/{workspaceId}/todos/{todoId})
+	ReplaceTodo(ctx echo.Context, workspaceId string, todoId string, params ReplaceTodoParams) error
+	// List every revision of a TODO item, including TODOs in the trash.
+	// (GET /workspace/{workspaceId}/todos/{todoId}/history)
+	GetTodoHistory(ctx echo.Context, workspaceId string, todoId string) error
+	// Create, update, and delete many TODOs at once.
+	// (POST /workspace/{workspaceId}/todos:batch)
+	BatchTodos(ctx echo.Context, workspaceId string) error
+	// List the deleted TODOs in the trash of the workspace.
+	// (GET /workspace/{workspaceId}/trash)
+	ListTrash(ctx echo.Context, workspaceId string, params ListTrashParams) error
+	// Restore a deleted TODO from the trash with its original id.
+	// (POST /workspace/{workspaceId}/trash/{todoId}/restore)
+	RestoreTodo(ctx echo.Context, workspaceId string, todoId string) error
+	// Get the TODO status workflow of the workspace.
+	// (GET /workspace/{workspaceId}/workflow)
+	GetWorkflow(ctx echo.Context, workspaceId string) error
+	// Replace the TODO status workflow of the workspace.
+	// (PUT /workspace/{workspaceId}/workflow)
+	SetWorkflow(ctx echo.Context, workspaceId string) error
+	// List the workspaces.
+	// (GET /workspaces)
+	ListWorkspaces(ctx echo.Context, params ListWorkspacesParams) error
+	// Create a new workspace.
+	// (POST /workspaces)
+	CreateWorkspace(ctx echo.Context) error
 }
 
-type ListTodosdefaultJSONResponse struct {
-	Body       Problem
-	StatusCode int
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
 }
 
-func (response ListTodosdefaultJSONResponse) VisitListTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(response.StatusCode)
+// ExchangeIdToken converts echo context to params.
+func (w *ServerInterfaceWrapper) ExchangeIdToken(ctx echo.Context) error {
+	var err error
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type CreateTodoRequestObject struct {
-	WorkspaceId string `json:"workspaceId"`
-	Body        *CreateTodoJSONRequestBody
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExchangeIdToken(ctx)
+	return err
 }
 
-type CreateTodoResponseObject interface {
-	VisitCreateTodoResponse(w http.ResponseWriter) error
+// CreateToken converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateToken(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateToken(ctx)
+	return err
 }
 
-type CreateTodo201JSONResponse Todo
+// GetHealthZ converts echo context to params.
+func (w *ServerInterfaceWrapper) GetHealthZ(ctx echo.Context) error {
+	var err error
 
-func (response CreateTodo201JSONResponse) VisitCreateTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	ctx.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetHealthZ(ctx)
+	return err
 }
 
-type CreateTodo400JSONResponse struct {
-	StandardBadRequestProblemJSONResponse
+// CreateUser converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateUser(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateUser(ctx)
+	return err
 }
 
-func (response CreateTodo400JSONResponse) VisitCreateTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// GetCurrentUser converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCurrentUser(ctx echo.Context) error {
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCurrentUser(ctx)
+	return err
 }
 
-type CreateTodo404JSONResponse struct {
-	StandardNotFoundProblemJSONResponse
+// ListApiKeys converts echo context to params.
+func (w *ServerInterfaceWrapper) ListApiKeys(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListApiKeys(ctx)
+	return err
 }
 
-func (response CreateTodo404JSONResponse) VisitCreateTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// CreateApiKey converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateApiKey(ctx echo.Context) error {
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateApiKey(ctx)
+	return err
 }
 
-type CreateTododefaultJSONResponse struct {
-	Body       Problem
-	StatusCode int
+// DeleteApiKey converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteApiKey(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "keyId" -------------
+	var keyId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "keyId", runtime.ParamLocationPath, ctx.Param("keyId"), &keyId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter keyId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteApiKey(ctx, keyId)
+	return err
 }
 
-func (response CreateTododefaultJSONResponse) VisitCreateTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(response.StatusCode)
+// DeleteWorkspace converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteWorkspace(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"workspace:admin"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteWorkspace(ctx, workspaceId)
+	return err
+}
+
+// GetWorkspace converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWorkspace(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"todos:read"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWorkspace(ctx, workspaceId)
+	return err
+}
+
+// StreamTodoEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) StreamTodoEvents(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"todos:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamTodoEventsParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Last-Event-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, valueList[0], &LastEventID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Last-Event-ID: %s", err))
+		}
+
+		params.LastEventID = &LastEventID
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StreamTodoEvents(ctx, workspaceId, params)
+	return err
+}
+
+// ExportTodos converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportTodos(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"todos:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportTodosParams
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExportTodos(ctx, workspaceId, params)
+	return err
+}
+
+// ListGroups converts echo context to params.
+func (w *ServerInterfaceWrapper) ListGroups(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"todos:read"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListGroups(ctx, workspaceId)
+	return err
+}
+
+// CreateGroup converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateGroup(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"todos:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateGroup(ctx, workspaceId)
+	return err
+}
+
+// DeleteGroup converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteGroup(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	// ------------- Path parameter "groupId" -------------
+	var groupId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "groupId", runtime.ParamLocationPath, ctx.Param("groupId"), &groupId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"todos:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteGroup(ctx, workspaceId, groupId)
+	return err
+}
+
+// GetGroup converts echo context to params.
+func (w *ServerInterfaceWrapper) GetGroup(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	// ------------- Path parameter "groupId" -------------
+	var groupId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "groupId", runtime.ParamLocationPath, ctx.Param("groupId"), &groupId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"todos:read"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetGroup(ctx, workspaceId, groupId)
+	return err
+}
+
+// UpdateGroup converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateGroup(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	// ------------- Path parameter "groupId" -------------
+	var groupId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "groupId", runtime.ParamLocationPath, ctx.Param("groupId"), &groupId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"todos:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateGroup(ctx, workspaceId, groupId)
+	return err
+}
+
+// ImportTodos converts echo context to params.
+func (w *ServerInterfaceWrapper) ImportTodos(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"todos:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportTodosParams
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ImportTodos(ctx, workspaceId, params)
+	return err
+}
+
+// ListWorkspaceMembers converts echo context to params.
+func (w *ServerInterfaceWrapper) ListWorkspaceMembers(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"todos:read"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListWorkspaceMembers(ctx, workspaceId)
+	return err
+}
+
+// RemoveWorkspaceMember converts echo context to params.
+func (w *ServerInterfaceWrapper) RemoveWorkspaceMember(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userId", runtime.ParamLocationPath, ctx.Param("userId"), &userId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"workspace:admin"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RemoveWorkspaceMember(ctx, workspaceId, userId)
+	return err
+}
+
+// SetWorkspaceMember converts echo context to params.
+func (w *ServerInterfaceWrapper) SetWorkspaceMember(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userId", runtime.ParamLocationPath, ctx.Param("userId"), &userId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"workspace:admin"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetWorkspaceMember(ctx, workspaceId, userId)
+	return err
+}
+
+// ListServiceAccounts converts echo context to params.
+func (w *ServerInterfaceWrapper) ListServiceAccounts(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"workspace:admin"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListServiceAccounts(ctx, workspaceId)
+	return err
+}
+
+// CreateServiceAccount converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateServiceAccount(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"workspace:admin"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateServiceAccount(ctx, workspaceId)
+	return err
+}
+
+// DeleteServiceAccount converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteServiceAccount(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	// ------------- Path parameter "accountId" -------------
+	var accountId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "accountId", runtime.ParamLocationPath, ctx.Param("accountId"), &accountId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"workspace:admin"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteServiceAccount(ctx, workspaceId, accountId)
+	return err
+}
+
+// ListServiceAccountTokens converts echo context to params.
+func (w *ServerInterfaceWrapper) ListServiceAccountTokens(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	// ------------- Path parameter "accountId" -------------
+	var accountId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "accountId", runtime.ParamLocationPath, ctx.Param("accountId"), &accountId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"workspace:admin"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListServiceAccountTokens(ctx, workspaceId, accountId)
+	return err
+}
+
+// CreateServiceAccountToken converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateServiceAccountToken(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	// ------------- Path parameter "accountId" -------------
+	var accountId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "accountId", runtime.ParamLocationPath, ctx.Param("accountId"), &accountId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"workspace:admin"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateServiceAccountToken(ctx, workspaceId, accountId)
+	return err
+}
+
+// RevokeServiceAccountToken converts echo context to params.
+func (w *ServerInterfaceWrapper) RevokeServiceAccountToken(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	// ------------- Path parameter "accountId" -------------
+	var accountId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "accountId", runtime.ParamLocationPath, ctx.Param("accountId"), &accountId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accountId: %s", err))
+	}
+
+	// ------------- Path parameter "tokenId" -------------
+	var tokenId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tokenId", runtime.ParamLocationPath, ctx.Param("tokenId"), &tokenId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tokenId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"workspace:admin"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RevokeServiceAccountToken(ctx, workspaceId, accountId, tokenId)
+	return err
+}
+
+// ListTodos converts echo context to params.
+func (w *ServerInterfaceWrapper) ListTodos(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"todos:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTodosParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page_size: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "group" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "group", ctx.QueryParams(), &params.Group)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter group: %s", err))
+	}
+
+	// ------------- Optional query parameter "assignee" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "assignee", ctx.QueryParams(), &params.Assignee)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assignee: %s", err))
+	}
+
+	// ------------- Optional query parameter "created_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_after", ctx.QueryParams(), &params.CreatedAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_after: %s", err))
+	}
+
+	// ------------- Optional query parameter "created_before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_before", ctx.QueryParams(), &params.CreatedBefore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_before: %s", err))
+	}
+
+	// ------------- Optional query parameter "updated_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "updated_after", ctx.QueryParams(), &params.UpdatedAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter updated_after: %s", err))
+	}
+
+	// ------------- Optional query parameter "updated_before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "updated_before", ctx.QueryParams(), &params.UpdatedBefore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter updated_before: %s", err))
+	}
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", ctx.QueryParams(), &params.Q)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort_updated_at" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_updated_at", ctx.QueryParams(), &params.SortUpdatedAt)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort_updated_at: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListTodos(ctx, workspaceId, params)
+	return err
+}
+
+// CreateTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateTodo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"todos:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateTodo(ctx, workspaceId)
+	return err
+}
+
+// DeleteTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTodo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "todoId", runtime.ParamLocationPath, ctx.Param("todoId"), &todoId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"todos:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTodoParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTodo(ctx, workspaceId, todoId, params)
+	return err
+}
+
+// GetTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTodo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "todoId", runtime.ParamLocationPath, ctx.Param("todoId"), &todoId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"todos:read"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTodo(ctx, workspaceId, todoId)
+	return err
+}
+
+// UpdateTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateTodo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "todoId", runtime.ParamLocationPath, ctx.Param("todoId"), &todoId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"todos:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateTodoParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateTodo(ctx, workspaceId, todoId, params)
+	return err
+}
+
+// ReplaceTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) ReplaceTodo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "todoId", runtime.ParamLocationPath, ctx.Param("todoId"), &todoId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"todos:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReplaceTodoParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ReplaceTodo(ctx, workspaceId, todoId, params)
+	return err
+}
+
+// GetTodoHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTodoHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "todoId", runtime.ParamLocationPath, ctx.Param("todoId"), &todoId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"todos:read"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTodoHistory(ctx, workspaceId, todoId)
+	return err
+}
+
+// BatchTodos converts echo context to params.
+func (w *ServerInterfaceWrapper) BatchTodos(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"todos:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.BatchTodos(ctx, workspaceId)
+	return err
+}
+
+// ListTrash converts echo context to params.
+func (w *ServerInterfaceWrapper) ListTrash(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"todos:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTrashParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page_size: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListTrash(ctx, workspaceId, params)
+	return err
+}
+
+// RestoreTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) RestoreTodo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "todoId", runtime.ParamLocationPath, ctx.Param("todoId"), &todoId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"todos:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RestoreTodo(ctx, workspaceId, todoId)
+	return err
+}
+
+// GetWorkflow converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWorkflow(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"todos:read"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWorkflow(ctx, workspaceId)
+	return err
+}
+
+// SetWorkflow converts echo context to params.
+func (w *ServerInterfaceWrapper) SetWorkflow(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workspaceId", runtime.ParamLocationPath, ctx.Param("workspaceId"), &workspaceId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"workspace:admin"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetWorkflow(ctx, workspaceId)
+	return err
+}
+
+// ListWorkspaces converts echo context to params.
+func (w *ServerInterfaceWrapper) ListWorkspaces(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWorkspacesParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", ctx.QueryParams(), &params.PageSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page_size: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListWorkspaces(ctx, params)
+	return err
+}
+
+// CreateWorkspace converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateWorkspace(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateWorkspace(ctx)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.POST(baseURL+"/auth/oidc", wrapper.ExchangeIdToken)
+	router.POST(baseURL+"/auth/token", wrapper.CreateToken)
+	router.GET(baseURL+"/healthz", wrapper.GetHealthZ)
+	router.POST(baseURL+"/users", wrapper.CreateUser)
+	router.GET(baseURL+"/users/me", wrapper.GetCurrentUser)
+	router.GET(baseURL+"/users/me/api-keys", wrapper.ListApiKeys)
+	router.POST(baseURL+"/users/me/api-keys", wrapper.CreateApiKey)
+	router.DELETE(baseURL+"/users/me/api-keys/:keyId", wrapper.DeleteApiKey)
+	router.DELETE(baseURL+"/workspace/:workspaceId", wrapper.DeleteWorkspace)
+	router.GET(baseURL+"/workspace/:workspaceId", wrapper.GetWorkspace)
+	router.GET(baseURL+"/workspace/:workspaceId/events", wrapper.StreamTodoEvents)
+	router.GET(baseURL+"/workspace/:workspaceId/export", wrapper.ExportTodos)
+	router.GET(baseURL+"/workspace/:workspaceId/groups", wrapper.ListGroups)
+	router.POST(baseURL+"/workspace/:workspaceId/groups", wrapper.CreateGroup)
+	router.DELETE(baseURL+"/workspace/:workspaceId/groups/:groupId", wrapper.DeleteGroup)
+	router.GET(baseURL+"/workspace/:workspaceId/groups/:groupId", wrapper.GetGroup)
+	router.PATCH(baseURL+"/workspace/:workspaceId/groups/:groupId", wrapper.UpdateGroup)
+	router.POST(baseURL+"/workspace/:workspaceId/import", wrapper.ImportTodos)
+	router.GET(baseURL+"/workspace/:workspaceId/members", wrapper.ListWorkspaceMembers)
+	router.DELETE(baseURL+"/workspace/:workspaceId/members/:userId", wrapper.RemoveWorkspaceMember)
+	router.PUT(baseURL+"/workspace/:workspaceId/members/:userId", wrapper.SetWorkspaceMember)
+	router.GET(baseURL+"/workspace/:workspaceId/service-accounts", wrapper.ListServiceAccounts)
+	router.POST(baseURL+"/workspace/:workspaceId/service-accounts", wrapper.CreateServiceAccount)
+	router.DELETE(baseURL+"/workspace/:workspaceId/service-accounts/:accountId", wrapper.DeleteServiceAccount)
+	router.GET(baseURL+"/workspace/:workspaceId/service-accounts/:accountId/tokens", wrapper.ListServiceAccountTokens)
+	router.POST(baseURL+"/workspace/:workspaceId/service-accounts/:accountId/tokens", wrapper.CreateServiceAccountToken)
+	router.DELETE(baseURL+"/workspace/:workspaceId/service-accounts/:accountId/tokens/:tokenId", wrapper.RevokeServiceAccountToken)
+	router.GET(baseURL+"/workspace/:workspaceId/todos", wrapper.ListTodos)
+	router.POST(baseURL+"/workspace/:workspaceId/todos", wrapper.CreateTodo)
+	router.DELETE(baseURL+"/workspace/:workspaceId/todos/:todoId", wrapper.DeleteTodo)
+	router.GET(baseURL+"/workspace/:workspaceId/todos/:todoId", wrapper.GetTodo)
+	router.PATCH(baseURL+"/workspace/:workspaceId/todos/:todoId", wrapper.UpdateTodo)
+	router.PUT(baseURL+"/workspace/:workspaceId/todos/:todoId", wrapper.ReplaceTodo)
+	router.GET(baseURL+"/workspace/:workspaceId/todos/:todoId/history", wrapper.GetTodoHistory)
+	router.POST(baseURL+"/workspace/:workspaceId/todos:batch", wrapper.BatchTodos)
+	router.GET(baseURL+"/workspace/:workspaceId/trash", wrapper.ListTrash)
+	router.POST(baseURL+"/workspace/:workspaceId/trash/:todoId/restore", wrapper.RestoreTodo)
+	router.GET(baseURL+"/workspace/:workspaceId/workflow", wrapper.GetWorkflow)
+	router.PUT(baseURL+"/workspace/:workspaceId/workflow", wrapper.SetWorkflow)
+	router.GET(baseURL+"/workspaces", wrapper.ListWorkspaces)
+	router.POST(baseURL+"/workspaces", wrapper.CreateWorkspace)
+
+}
+
+type StandardBadRequestProblemJSONResponse Problem
+
+type StandardConflictProblemJSONResponse Problem
+
+type StandardForbiddenProblemJSONResponse Problem
+
+type StandardNotFoundProblemJSONResponse Problem
+
+type StandardPreconditionFailedProblemJSONResponse Problem
+
+type StandardProblemResponseJSONResponse Problem
+
+type StandardUnauthorizedProblemJSONResponse Problem
+
+type ExchangeIdTokenRequestObject struct {
+	Body *ExchangeIdTokenJSONRequestBody
+}
+
+type ExchangeIdTokenResponseObject interface {
+	VisitExchangeIdTokenResponse(w http.ResponseWriter) error
+}
+
+type ExchangeIdToken200JSONResponse AccessToken
+
+func (response ExchangeIdToken200JSONResponse) VisitExchangeIdTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExchangeIdToken400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response ExchangeIdToken400JSONResponse) VisitExchangeIdTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExchangeIdToken401JSONResponse struct {
+	StandardUnauthorizedProblemJSONResponse
+}
+
+func (response ExchangeIdToken401JSONResponse) VisitExchangeIdTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExchangeIdTokendefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response ExchangeIdTokendefaultJSONResponse) VisitExchangeIdTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateTokenRequestObject struct {
+}
+
+type CreateTokenResponseObject interface {
+	VisitCreateTokenResponse(w http.ResponseWriter) error
+}
+
+type CreateToken200JSONResponse AccessToken
+
+func (response CreateToken200JSONResponse) VisitCreateTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateToken401JSONResponse struct {
+	StandardUnauthorizedProblemJSONResponse
+}
+
+func (response CreateToken401JSONResponse) VisitCreateTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTokendefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response CreateTokendefaultJSONResponse) VisitCreateTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetHealthZRequestObject struct {
+}
+
+type GetHealthZResponseObject interface {
+	VisitGetHealthZResponse(w http.ResponseWriter) error
+}
+
+type GetHealthZ200JSONResponse HealthZ
+
+func (response GetHealthZ200JSONResponse) VisitGetHealthZResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHealthZdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response GetHealthZdefaultJSONResponse) VisitGetHealthZResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateUserRequestObject struct {
+	Body *CreateUserJSONRequestBody
+}
+
+type CreateUserResponseObject interface {
+	VisitCreateUserResponse(w http.ResponseWriter) error
+}
+
+type CreateUser201JSONResponse CreatedUser
+
+func (response CreateUser201JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUser400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response CreateUser400JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUser403JSONResponse struct {
+	StandardForbiddenProblemJSONResponse
+}
+
+func (response CreateUser403JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUser409JSONResponse struct {
+	StandardConflictProblemJSONResponse
+}
+
+func (response CreateUser409JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUserdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response CreateUserdefaultJSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetCurrentUserRequestObject struct {
+}
+
+type GetCurrentUserResponseObject interface {
+	VisitGetCurrentUserResponse(w http.ResponseWriter) error
+}
+
+type GetCurrentUser200JSONResponse User
+
+func (response GetCurrentUser200JSONResponse) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCurrentUser401JSONResponse struct {
+	StandardUnauthorizedProblemJSONResponse
+}
+
+func (response GetCurrentUser401JSONResponse) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCurrentUserdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response GetCurrentUserdefaultJSONResponse) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListApiKeysRequestObject struct {
+}
+
+type ListApiKeysResponseObject interface {
+	VisitListApiKeysResponse(w http.ResponseWriter) error
+}
+
+type ListApiKeys200JSONResponse ApiKeyList
+
+func (response ListApiKeys200JSONResponse) VisitListApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListApiKeys401JSONResponse struct {
+	StandardUnauthorizedProblemJSONResponse
+}
+
+func (response ListApiKeys401JSONResponse) VisitListApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListApiKeysdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response ListApiKeysdefaultJSONResponse) VisitListApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateApiKeyRequestObject struct {
+	Body *CreateApiKeyJSONRequestBody
+}
+
+type CreateApiKeyResponseObject interface {
+	VisitCreateApiKeyResponse(w http.ResponseWriter) error
+}
+
+type CreateApiKey201JSONResponse ApiKey
+
+func (response CreateApiKey201JSONResponse) VisitCreateApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateApiKey400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response CreateApiKey400JSONResponse) VisitCreateApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateApiKey401JSONResponse struct {
+	StandardUnauthorizedProblemJSONResponse
+}
+
+func (response CreateApiKey401JSONResponse) VisitCreateApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateApiKeydefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response CreateApiKeydefaultJSONResponse) VisitCreateApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteApiKeyRequestObject struct {
+	KeyId string `json:"keyId"`
+}
+
+type DeleteApiKeyResponseObject interface {
+	VisitDeleteApiKeyResponse(w http.ResponseWriter) error
+}
+
+type DeleteApiKey204Response struct {
+}
+
+func (response DeleteApiKey204Response) VisitDeleteApiKeyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteApiKey401JSONResponse struct {
+	StandardUnauthorizedProblemJSONResponse
+}
+
+func (response DeleteApiKey401JSONResponse) VisitDeleteApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiKey404JSONResponse struct {
+	StandardNotFoundProblemJSONResponse
+}
+
+func (response DeleteApiKey404JSONResponse) VisitDeleteApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiKeydefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response DeleteApiKeydefaultJSONResponse) VisitDeleteApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteWorkspaceRequestObject struct {
+	WorkspaceId string `json:"workspaceId"`
+}
+
+type DeleteWorkspaceResponseObject interface {
+	VisitDeleteWorkspaceResponse(w http.ResponseWriter) error
+}
+
+type DeleteWorkspace204Response struct {
+}
+
+func (response DeleteWorkspace204Response) VisitDeleteWorkspaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteWorkspace400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response DeleteWorkspace400JSONResponse) VisitDeleteWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWorkspace404JSONResponse struct {
+	StandardNotFoundProblemJSONResponse
+}
+
+func (response DeleteWorkspace404JSONResponse) VisitDeleteWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWorkspacedefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response DeleteWorkspacedefaultJSONResponse) VisitDeleteWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetWorkspaceRequestObject struct {
+	WorkspaceId string `json:"workspaceId"`
+}
+
+type GetWorkspaceResponseObject interface {
+	VisitGetWorkspaceResponse(w http.ResponseWriter) error
+}
+
+type GetWorkspace200JSONResponse Workspace
+
+func (response GetWorkspace200JSONResponse) VisitGetWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkspace400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response GetWorkspace400JSONResponse) VisitGetWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkspace404JSONResponse struct {
+	StandardNotFoundProblemJSONResponse
+}
+
+func (response GetWorkspace404JSONResponse) VisitGetWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkspacedefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response GetWorkspacedefaultJSONResponse) VisitGetWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type StreamTodoEventsRequestObject struct {
+	WorkspaceId string `json:"workspaceId"`
+	Params      StreamTodoEventsParams
+}
+
+type StreamTodoEventsResponseObject interface {
+	VisitStreamTodoEventsResponse(w http.ResponseWriter) error
+}
+
+type StreamTodoEvents200ResponseHeaders struct {
+	CacheControl string
+}
+
+type StreamTodoEvents200TexteventStreamResponse struct {
+	Body          io.Reader
+	Headers       StreamTodoEvents200ResponseHeaders
+	ContentLength int64
+}
+
+func (response StreamTodoEvents200TexteventStreamResponse) VisitStreamTodoEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type StreamTodoEvents400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response StreamTodoEvents400JSONResponse) VisitStreamTodoEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamTodoEvents404JSONResponse struct {
+	StandardNotFoundProblemJSONResponse
+}
+
+func (response StreamTodoEvents404JSONResponse) VisitStreamTodoEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamTodoEventsdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response StreamTodoEventsdefaultJSONResponse) VisitStreamTodoEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ExportTodosRequestObject struct {
+	WorkspaceId string `json:"workspaceId"`
+	Params      ExportTodosParams
+}
+
+type ExportTodosResponseObject interface {
+	VisitExportTodosResponse(w http.ResponseWriter) error
+}
+
+type ExportTodos200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response ExportTodos200ApplicationxNdjsonResponse) VisitExportTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportTodos200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response ExportTodos200TextcsvResponse) VisitExportTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportTodos200TextmarkdownResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response ExportTodos200TextmarkdownResponse) VisitExportTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/markdown")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportTodos400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response ExportTodos400JSONResponse) VisitExportTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportTodos404JSONResponse struct {
+	StandardNotFoundProblemJSONResponse
+}
+
+func (response ExportTodos404JSONResponse) VisitExportTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportTodosdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response ExportTodosdefaultJSONResponse) VisitExportTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListGroupsRequestObject struct {
+	WorkspaceId string `json:"workspaceId"`
+}
+
+type ListGroupsResponseObject interface {
+	VisitListGroupsResponse(w http.ResponseWriter) error
+}
+
+type ListGroups200JSONResponse GroupList
+
+func (response ListGroups200JSONResponse) VisitListGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroups400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response ListGroups400JSONResponse) VisitListGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroups404JSONResponse struct {
+	StandardNotFoundProblemJSONResponse
+}
+
+func (response ListGroups404JSONResponse) VisitListGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroupsdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response ListGroupsdefaultJSONResponse) VisitListGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateGroupRequestObject struct {
+	WorkspaceId string `json:"workspaceId"`
+	Body        *CreateGroupJSONRequestBody
+}
+
+type CreateGroupResponseObject interface {
+	VisitCreateGroupResponse(w http.ResponseWriter) error
+}
+
+type CreateGroup201JSONResponse Group
+
+func (response CreateGroup201JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGroup400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response CreateGroup400JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGroup404JSONResponse struct {
+	StandardNotFoundProblemJSONResponse
+}
+
+func (response CreateGroup404JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGroup409JSONResponse struct {
+	StandardConflictProblemJSONResponse
+}
+
+func (response CreateGroup409JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGroupdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response CreateGroupdefaultJSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteGroupRequestObject struct {
+	WorkspaceId string `json:"workspaceId"`
+	GroupId     string `json:"groupId"`
+}
+
+type DeleteGroupResponseObject interface {
+	VisitDeleteGroupResponse(w http.ResponseWriter) error
+}
+
+type DeleteGroup204Response struct {
+}
+
+func (response DeleteGroup204Response) VisitDeleteGroupResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteGroup400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response DeleteGroup400JSONResponse) VisitDeleteGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteGroup404JSONResponse struct {
+	StandardNotFoundProblemJSONResponse
+}
+
+func (response DeleteGroup404JSONResponse) VisitDeleteGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteGroupdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response DeleteGroupdefaultJSONResponse) VisitDeleteGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetGroupRequestObject struct {
+	WorkspaceId string `json:"workspaceId"`
+	GroupId     string `json:"groupId"`
+}
+
+type GetGroupResponseObject interface {
+	VisitGetGroupResponse(w http.ResponseWriter) error
+}
+
+type GetGroup200JSONResponse Group
+
+func (response GetGroup200JSONResponse) VisitGetGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGroup400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response GetGroup400JSONResponse) VisitGetGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGroup404JSONResponse struct {
+	StandardNotFoundProblemJSONResponse
+}
+
+func (response GetGroup404JSONResponse) VisitGetGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGroupdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response GetGroupdefaultJSONResponse) VisitGetGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateGroupRequestObject struct {
+	WorkspaceId string `json:"workspaceId"`
+	GroupId     string `json:"groupId"`
+	Body        *UpdateGroupJSONRequestBody
+}
+
+type UpdateGroupResponseObject interface {
+	VisitUpdateGroupResponse(w http.ResponseWriter) error
+}
+
+type UpdateGroup200JSONResponse Group
+
+func (response UpdateGroup200JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGroup400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response UpdateGroup400JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGroup404JSONResponse struct {
+	StandardNotFoundProblemJSONResponse
+}
+
+func (response UpdateGroup404JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGroupdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response UpdateGroupdefaultJSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ImportTodosRequestObject struct {
+	WorkspaceId string `json:"workspaceId"`
+	Params      ImportTodosParams
+	Body        io.Reader
+}
+
+type ImportTodosResponseObject interface {
+	VisitImportTodosResponse(w http.ResponseWriter) error
+}
+
+type ImportTodos200JSONResponse TodoImport
+
+func (response ImportTodos200JSONResponse) VisitImportTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTodos400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response ImportTodos400JSONResponse) VisitImportTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTodos404JSONResponse struct {
+	StandardNotFoundProblemJSONResponse
+}
+
+func (response ImportTodos404JSONResponse) VisitImportTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTodosdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response ImportTodosdefaultJSONResponse) VisitImportTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListWorkspaceMembersRequestObject struct {
+	WorkspaceId string `json:"workspaceId"`
+}
+
+type ListWorkspaceMembersResponseObject interface {
+	VisitListWorkspaceMembersResponse(w http.ResponseWriter) error
+}
+
+type ListWorkspaceMembers200JSONResponse WorkspaceMemberList
+
+func (response ListWorkspaceMembers200JSONResponse) VisitListWorkspaceMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkspaceMembers400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response ListWorkspaceMembers400JSONResponse) VisitListWorkspaceMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkspaceMembers401JSONResponse struct {
+	StandardUnauthorizedProblemJSONResponse
+}
+
+func (response ListWorkspaceMembers401JSONResponse) VisitListWorkspaceMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkspaceMembers403JSONResponse struct {
+	StandardForbiddenProblemJSONResponse
+}
+
+func (response ListWorkspaceMembers403JSONResponse) VisitListWorkspaceMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkspaceMembersdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response ListWorkspaceMembersdefaultJSONResponse) VisitListWorkspaceMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RemoveWorkspaceMemberRequestObject struct {
+	WorkspaceId string `json:"workspaceId"`
+	UserId      string `json:"userId"`
+}
+
+type RemoveWorkspaceMemberResponseObject interface {
+	VisitRemoveWorkspaceMemberResponse(w http.ResponseWriter) error
+}
+
+type RemoveWorkspaceMember204Response struct {
+}
+
+func (response RemoveWorkspaceMember204Response) VisitRemoveWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RemoveWorkspaceMember400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response RemoveWorkspaceMember400JSONResponse) VisitRemoveWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveWorkspaceMember401JSONResponse struct {
+	StandardUnauthorizedProblemJSONResponse
+}
+
+func (response RemoveWorkspaceMember401JSONResponse) VisitRemoveWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveWorkspaceMember403JSONResponse struct {
+	StandardForbiddenProblemJSONResponse
+}
+
+func (response RemoveWorkspaceMember403JSONResponse) VisitRemoveWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveWorkspaceMember404JSONResponse struct {
+	StandardNotFoundProblemJSONResponse
+}
+
+func (response RemoveWorkspaceMember404JSONResponse) VisitRemoveWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveWorkspaceMemberdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response RemoveWorkspaceMemberdefaultJSONResponse) VisitRemoveWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetWorkspaceMemberRequestObject struct {
+	WorkspaceId string `json:"workspaceId"`
+	UserId      string `json:"userId"`
+	Body        *SetWorkspaceMemberJSONRequestBody
+}
+
+type SetWorkspaceMemberResponseObject interface {
+	VisitSetWorkspaceMemberResponse(w http.ResponseWriter) error
+}
+
+type SetWorkspaceMember200JSONResponse WorkspaceMember
+
+func (response SetWorkspaceMember200JSONResponse) VisitSetWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetWorkspaceMember400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response SetWorkspaceMember400JSONResponse) VisitSetWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetWorkspaceMember401JSONResponse struct {
+	StandardUnauthorizedProblemJSONResponse
+}
+
+func (response SetWorkspaceMember401JSONResponse) VisitSetWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetWorkspaceMember403JSONResponse struct {
+	StandardForbiddenProblemJSONResponse
+}
+
+func (response SetWorkspaceMember403JSONResponse) VisitSetWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetWorkspaceMember404JSONResponse struct {
+	StandardNotFoundProblemJSONResponse
+}
+
+func (response SetWorkspaceMember404JSONResponse) VisitSetWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetWorkspaceMemberdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response SetWorkspaceMemberdefaultJSONResponse) VisitSetWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListServiceAccountsRequestObject struct {
+	WorkspaceId string `json:"workspaceId"`
+}
+
+type ListServiceAccountsResponseObject interface {
+	VisitListServiceAccountsResponse(w http.ResponseWriter) error
+}
+
+type ListServiceAccounts200JSONResponse ServiceAccountList
+
+func (response ListServiceAccounts200JSONResponse) VisitListServiceAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListServiceAccounts400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response ListServiceAccounts400JSONResponse) VisitListServiceAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListServiceAccounts401JSONResponse struct {
+	StandardUnauthorizedProblemJSONResponse
+}
+
+func (response ListServiceAccounts401JSONResponse) VisitListServiceAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListServiceAccounts403JSONResponse struct {
+	StandardForbiddenProblemJSONResponse
+}
+
+func (response ListServiceAccounts403JSONResponse) VisitListServiceAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListServiceAccounts404JSONResponse struct {
+	StandardNotFoundProblemJSONResponse
+}
+
+func (response ListServiceAccounts404JSONResponse) VisitListServiceAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListServiceAccountsdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response ListServiceAccountsdefaultJSONResponse) VisitListServiceAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateServiceAccountRequestObject struct {
+	WorkspaceId string `json:"workspaceId"`
+	Body        *CreateServiceAccountJSONRequestBody
+}
+
+type CreateServiceAccountResponseObject interface {
+	VisitCreateServiceAccountResponse(w http.ResponseWriter) error
+}
+
+type CreateServiceAccount201JSONResponse ServiceAccount
+
+func (response CreateServiceAccount201JSONResponse) VisitCreateServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServiceAccount400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response CreateServiceAccount400JSONResponse) VisitCreateServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServiceAccount401JSONResponse struct {
+	StandardUnauthorizedProblemJSONResponse
+}
+
+func (response CreateServiceAccount401JSONResponse) VisitCreateServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServiceAccount403JSONResponse struct {
+	StandardForbiddenProblemJSONResponse
+}
+
+func (response CreateServiceAccount403JSONResponse) VisitCreateServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServiceAccount404JSONResponse struct {
+	StandardNotFoundProblemJSONResponse
+}
+
+func (response CreateServiceAccount404JSONResponse) VisitCreateServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServiceAccountdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response CreateServiceAccountdefaultJSONResponse) VisitCreateServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteServiceAccountRequestObject struct {
+	WorkspaceId string `json:"workspaceId"`
+	AccountId   string `json:"accountId"`
+}
+
+type DeleteServiceAccountResponseObject interface {
+	VisitDeleteServiceAccountResponse(w http.ResponseWriter) error
+}
+
+type DeleteServiceAccount204Response struct {
+}
+
+func (response DeleteServiceAccount204Response) VisitDeleteServiceAccountResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteServiceAccount400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response DeleteServiceAccount400JSONResponse) VisitDeleteServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteServiceAccount401JSONResponse struct {
+	StandardUnauthorizedProblemJSONResponse
+}
+
+func (response DeleteServiceAccount401JSONResponse) VisitDeleteServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteServiceAccount403JSONResponse struct {
+	StandardForbiddenProblemJSONResponse
+}
+
+func (response DeleteServiceAccount403JSONResponse) VisitDeleteServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteServiceAccount404JSONResponse struct {
+	StandardNotFoundProblemJSONResponse
+}
+
+func (response DeleteServiceAccount404JSONResponse) VisitDeleteServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteServiceAccountdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response DeleteServiceAccountdefaultJSONResponse) VisitDeleteServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListServiceAccountTokensRequestObject struct {
+	WorkspaceId string `json:"workspaceId"`
+	AccountId   string `json:"accountId"`
+}
+
+type ListServiceAccountTokensResponseObject interface {
+	VisitListServiceAccountTokensResponse(w http.ResponseWriter) error
+}
+
+type ListServiceAccountTokens200JSONResponse ServiceAccountTokenList
+
+func (response ListServiceAccountTokens200JSONResponse) VisitListServiceAccountTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListServiceAccountTokens400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response ListServiceAccountTokens400JSONResponse) VisitListServiceAccountTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListServiceAccountTokens401JSONResponse struct {
+	StandardUnauthorizedProblemJSONResponse
+}
+
+func (response ListServiceAccountTokens401JSONResponse) VisitListServiceAccountTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListServiceAccountTokens403JSONResponse struct {
+	StandardForbiddenProblemJSONResponse
+}
+
+func (response ListServiceAccountTokens403JSONResponse) VisitListServiceAccountTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListServiceAccountTokens404JSONResponse struct {
+	StandardNotFoundProblemJSONResponse
+}
+
+func (response ListServiceAccountTokens404JSONResponse) VisitListServiceAccountTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListServiceAccountTokensdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response ListServiceAccountTokensdefaultJSONResponse) VisitListServiceAccountTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateServiceAccountTokenRequestObject struct {
+	WorkspaceId string `json:"workspaceId"`
+	AccountId   string `json:"accountId"`
+	Body        *CreateServiceAccountTokenJSONRequestBody
+}
+
+type CreateServiceAccountTokenResponseObject interface {
+	VisitCreateServiceAccountTokenResponse(w http.ResponseWriter) error
+}
+
+type CreateServiceAccountToken201JSONResponse ServiceAccountToken
+
+func (response CreateServiceAccountToken201JSONResponse) VisitCreateServiceAccountTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServiceAccountToken400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response CreateServiceAccountToken400JSONResponse) VisitCreateServiceAccountTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServiceAccountToken401JSONResponse struct {
+	StandardUnauthorizedProblemJSONResponse
+}
+
+func (response CreateServiceAccountToken401JSONResponse) VisitCreateServiceAccountTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServiceAccountToken403JSONResponse struct {
+	StandardForbiddenProblemJSONResponse
+}
+
+func (response CreateServiceAccountToken403JSONResponse) VisitCreateServiceAccountTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServiceAccountToken404JSONResponse struct {
+	StandardNotFoundProblemJSONResponse
+}
+
+func (response CreateServiceAccountToken404JSONResponse) VisitCreateServiceAccountTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServiceAccountTokendefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response CreateServiceAccountTokendefaultJSONResponse) VisitCreateServiceAccountTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RevokeServiceAccountTokenRequestObject struct {
+	WorkspaceId string `json:"workspaceId"`
+	AccountId   string `json:"accountId"`
+	TokenId     string `json:"tokenId"`
+}
+
+type RevokeServiceAccountTokenResponseObject interface {
+	VisitRevokeServiceAccountTokenResponse(w http.ResponseWriter) error
+}
+
+type RevokeServiceAccountToken204Response struct {
+}
+
+func (response RevokeServiceAccountToken204Response) VisitRevokeServiceAccountTokenResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RevokeServiceAccountToken400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response RevokeServiceAccountToken400JSONResponse) VisitRevokeServiceAccountTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeServiceAccountToken401JSONResponse struct {
+	StandardUnauthorizedProblemJSONResponse
+}
+
+func (response RevokeServiceAccountToken401JSONResponse) VisitRevokeServiceAccountTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeServiceAccountToken403JSONResponse struct {
+	StandardForbiddenProblemJSONResponse
+}
+
+func (response RevokeServiceAccountToken403JSONResponse) VisitRevokeServiceAccountTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeServiceAccountToken404JSONResponse struct {
+	StandardNotFoundProblemJSONResponse
+}
+
+func (response RevokeServiceAccountToken404JSONResponse) VisitRevokeServiceAccountTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeServiceAccountTokendefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response RevokeServiceAccountTokendefaultJSONResponse) VisitRevokeServiceAccountTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListTodosRequestObject struct {
+	WorkspaceId string `json:"workspaceId"`
+	Params      ListTodosParams
+}
+
+type ListTodosResponseObject interface {
+	VisitListTodosResponse(w http.ResponseWriter) error
+}
+
+type ListTodos200JSONResponse TodoPage
+
+func (response ListTodos200JSONResponse) VisitListTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTodos400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response ListTodos400JSONResponse) VisitListTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTodos404JSONResponse struct {
+	StandardNotFoundProblemJSONResponse
+}
+
+func (response ListTodos404JSONResponse) VisitListTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTodosdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response ListTodosdefaultJSONResponse) VisitListTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateTodoRequestObject struct {
+	WorkspaceId string `json:"workspaceId"`
+	Body        *CreateTodoJSONRequestBody
+}
+
+type CreateTodoResponseObject interface {
+	VisitCreateTodoResponse(w http.ResponseWriter) error
+}
+
+type CreateTodo201ResponseHeaders struct {
+	ETag string
+}
+
+type CreateTodo201JSONResponse struct {
+	Body    Todo
+	Headers CreateTodo201ResponseHeaders
+}
+
+func (response CreateTodo201JSONResponse) VisitCreateTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateTodo400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response CreateTodo400JSONResponse) VisitCreateTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTodo404JSONResponse struct {
+	StandardNotFoundProblemJSONResponse
+}
+
+func (response CreateTodo404JSONResponse) VisitCreateTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTododefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response CreateTododefaultJSONResponse) VisitCreateTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteTodoRequestObject struct {
+	WorkspaceId string `json:"workspaceId"`
+	TodoId      string `json:"todoId"`
+	Params      DeleteTodoParams
+}
+
+type DeleteTodoResponseObject interface {
+	VisitDeleteTodoResponse(w http.ResponseWriter) error
+}
+
+type DeleteTodo204Response struct {
+}
+
+func (response DeleteTodo204Response) VisitDeleteTodoResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteTodo400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response DeleteTodo400JSONResponse) VisitDeleteTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTodo404JSONResponse struct {
+	StandardNotFoundProblemJSONResponse
+}
+
+func (response DeleteTodo404JSONResponse) VisitDeleteTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTodo412JSONResponse struct {
+	StandardPreconditionFailedProblemJSONResponse
+}
+
+func (response DeleteTodo412JSONResponse) VisitDeleteTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTododefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response DeleteTododefaultJSONResponse) VisitDeleteTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTodoRequestObject struct {
+	WorkspaceId string `json:"workspaceId"`
+	TodoId      string `json:"todoId"`
+}
+
+type GetTodoResponseObject interface {
+	VisitGetTodoResponse(w http.ResponseWriter) error
+}
+
+type GetTodo200ResponseHeaders struct {
+	ETag string
+}
+
+type GetTodo200JSONResponse struct {
+	Body    Todo
+	Headers GetTodo200ResponseHeaders
+}
+
+func (response GetTodo200JSONResponse) VisitGetTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTodo400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response GetTodo400JSONResponse) VisitGetTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTodo404JSONResponse struct {
+	StandardNotFoundProblemJSONResponse
+}
+
+func (response GetTodo404JSONResponse) VisitGetTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTododefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response GetTododefaultJSONResponse) VisitGetTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateTodoRequestObject struct {
+	WorkspaceId string `json:"workspaceId"`
+	TodoId      string `json:"todoId"`
+	Params      UpdateTodoParams
+	Body        *UpdateTodoJSONRequestBody
+}
+
+type UpdateTodoResponseObject interface {
+	VisitUpdateTodoResponse(w http.ResponseWriter) error
+}
+
+type UpdateTodo200ResponseHeaders struct {
+	ETag string
+}
+
+type UpdateTodo200JSONResponse struct {
+	Body    Todo
+	Headers UpdateTodo200ResponseHeaders
+}
+
+func (response UpdateTodo200JSONResponse) VisitUpdateTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateTodo400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response UpdateTodo400JSONResponse) VisitUpdateTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTodo404JSONResponse struct {
+	StandardNotFoundProblemJSONResponse
+}
+
+func (response UpdateTodo404JSONResponse) VisitUpdateTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTodo409JSONResponse struct {
+	StandardConflictProblemJSONResponse
+}
+
+func (response UpdateTodo409JSONResponse) VisitUpdateTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTodo412JSONResponse struct {
+	StandardPreconditionFailedProblemJSONResponse
+}
+
+func (response UpdateTodo412JSONResponse) VisitUpdateTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTododefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response UpdateTododefaultJSONResponse) VisitUpdateTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ReplaceTodoRequestObject struct {
+	WorkspaceId string `json:"workspaceId"`
+	TodoId      string `json:"todoId"`
+	Params      ReplaceTodoParams
+	Body        *ReplaceTodoJSONRequestBody
+}
+
+type ReplaceTodoResponseObject interface {
+	VisitReplaceTodoResponse(w http.ResponseWriter) error
+}
+
+type ReplaceTodo200ResponseHeaders struct {
+	ETag string
+}
+
+type ReplaceTodo200JSONResponse struct {
+	Body    Todo
+	Headers ReplaceTodo200ResponseHeaders
+}
+
+func (response ReplaceTodo200JSONResponse) VisitReplaceTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ReplaceTodo400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response ReplaceTodo400JSONResponse) VisitReplaceTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceTodo404JSONResponse struct {
+	StandardNotFoundProblemJSONResponse
+}
+
+func (response ReplaceTodo404JSONResponse) VisitReplaceTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceTodo409JSONResponse struct {
+	StandardConflictProblemJSONResponse
+}
+
+func (response ReplaceTodo409JSONResponse) VisitReplaceTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceTodo412JSONResponse struct {
+	StandardPreconditionFailedProblemJSONResponse
+}
+
+func (response ReplaceTodo412JSONResponse) VisitReplaceTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceTododefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response ReplaceTododefaultJSONResponse) VisitReplaceTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTodoHistoryRequestObject struct {
+	WorkspaceId string `json:"workspaceId"`
+	TodoId      string `json:"todoId"`
+}
+
+type GetTodoHistoryResponseObject interface {
+	VisitGetTodoHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetTodoHistory200JSONResponse TodoHistory
+
+func (response GetTodoHistory200JSONResponse) VisitGetTodoHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTodoHistory400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response GetTodoHistory400JSONResponse) VisitGetTodoHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTodoHistory404JSONResponse struct {
+	StandardNotFoundProblemJSONResponse
+}
+
+func (response GetTodoHistory404JSONResponse) VisitGetTodoHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTodoHistorydefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response GetTodoHistorydefaultJSONResponse) VisitGetTodoHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type BatchTodosRequestObject struct {
+	WorkspaceId string `json:"workspaceId"`
+	Body        *BatchTodosJSONRequestBody
+}
+
+type BatchTodosResponseObject interface {
+	VisitBatchTodosResponse(w http.ResponseWriter) error
+}
+
+type BatchTodos200JSONResponse TodoBatchResults
+
+func (response BatchTodos200JSONResponse) VisitBatchTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchTodos400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response BatchTodos400JSONResponse) VisitBatchTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchTodos404JSONResponse struct {
+	StandardNotFoundProblemJSONResponse
+}
+
+func (response BatchTodos404JSONResponse) VisitBatchTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchTodos409JSONResponse struct {
+	StandardConflictProblemJSONResponse
+}
+
+func (response BatchTodos409JSONResponse) VisitBatchTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchTodos412JSONResponse struct {
+	StandardPreconditionFailedProblemJSONResponse
+}
+
+func (response BatchTodos412JSONResponse) VisitBatchTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchTodosdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response BatchTodosdefaultJSONResponse) VisitBatchTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListTrashRequestObject struct {
+	WorkspaceId string `json:"workspaceId"`
+	Params      ListTrashParams
+}
+
+type ListTrashResponseObject interface {
+	VisitListTrashResponse(w http.ResponseWriter) error
+}
+
+type ListTrash200JSONResponse TodoPage
+
+func (response ListTrash200JSONResponse) VisitListTrashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTrash400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response ListTrash400JSONResponse) VisitListTrashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTrash404JSONResponse struct {
+	StandardNotFoundProblemJSONResponse
+}
+
+func (response ListTrash404JSONResponse) VisitListTrashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTrashdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response ListTrashdefaultJSONResponse) VisitListTrashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RestoreTodoRequestObject struct {
+	WorkspaceId string `json:"workspaceId"`
+	TodoId      string `json:"todoId"`
+}
+
+type RestoreTodoResponseObject interface {
+	VisitRestoreTodoResponse(w http.ResponseWriter) error
+}
+
+type RestoreTodo200JSONResponse Todo
+
+func (response RestoreTodo200JSONResponse) VisitRestoreTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreTodo400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response RestoreTodo400JSONResponse) VisitRestoreTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreTodo404JSONResponse struct {
+	StandardNotFoundProblemJSONResponse
+}
+
+func (response RestoreTodo404JSONResponse) VisitRestoreTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreTodo409JSONResponse struct {
+	StandardConflictProblemJSONResponse
+}
+
+func (response RestoreTodo409JSONResponse) VisitRestoreTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreTododefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response RestoreTododefaultJSONResponse) VisitRestoreTodoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetWorkflowRequestObject struct {
+	WorkspaceId string `json:"workspaceId"`
+}
+
+type GetWorkflowResponseObject interface {
+	VisitGetWorkflowResponse(w http.ResponseWriter) error
+}
+
+type GetWorkflow200JSONResponse Workflow
+
+func (response GetWorkflow200JSONResponse) VisitGetWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflow400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response GetWorkflow400JSONResponse) VisitGetWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflow404JSONResponse struct {
+	StandardNotFoundProblemJSONResponse
+}
+
+func (response GetWorkflow404JSONResponse) VisitGetWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflowdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response GetWorkflowdefaultJSONResponse) VisitGetWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetWorkflowRequestObject struct {
+	WorkspaceId string `json:"workspaceId"`
+	Body        *SetWorkflowJSONRequestBody
+}
+
+type SetWorkflowResponseObject interface {
+	VisitSetWorkflowResponse(w http.ResponseWriter) error
+}
+
+type SetWorkflow200JSONResponse Workflow
+
+func (response SetWorkflow200JSONResponse) VisitSetWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetWorkflow400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response SetWorkflow400JSONResponse) VisitSetWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetWorkflow404JSONResponse struct {
+	StandardNotFoundProblemJSONResponse
+}
+
+func (response SetWorkflow404JSONResponse) VisitSetWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetWorkflow409JSONResponse struct {
+	StandardConflictProblemJSONResponse
+}
+
+func (response SetWorkflow409JSONResponse) VisitSetWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetWorkflowdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response SetWorkflowdefaultJSONResponse) VisitSetWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListWorkspacesRequestObject struct {
+	Params ListWorkspacesParams
+}
+
+type ListWorkspacesResponseObject interface {
+	VisitListWorkspacesResponse(w http.ResponseWriter) error
+}
+
+type ListWorkspaces200JSONResponse WorkspacePage
+
+func (response ListWorkspaces200JSONResponse) VisitListWorkspacesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkspaces400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response ListWorkspaces400JSONResponse) VisitListWorkspacesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkspacesdefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response ListWorkspacesdefaultJSONResponse) VisitListWorkspacesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateWorkspaceRequestObject struct {
+	Body *CreateWorkspaceJSONRequestBody
+}
+
+type CreateWorkspaceResponseObject interface {
+	VisitCreateWorkspaceResponse(w http.ResponseWriter) error
+}
+
+type CreateWorkspace201JSONResponse Workspace
+
+func (response CreateWorkspace201JSONResponse) VisitCreateWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkspace400JSONResponse struct {
+	StandardBadRequestProblemJSONResponse
+}
+
+func (response CreateWorkspace400JSONResponse) VisitCreateWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkspace401JSONResponse struct {
+	StandardUnauthorizedProblemJSONResponse
+}
+
+func (response CreateWorkspace401JSONResponse) VisitCreateWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkspace409JSONResponse struct {
+	StandardConflictProblemJSONResponse
+}
+
+func (response CreateWorkspace409JSONResponse) VisitCreateWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkspacedefaultJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response CreateWorkspacedefaultJSONResponse) VisitCreateWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Exchange an OpenID Connect id token for a short-lived bearer token.
+	// (POST /auth/oidc)
+	ExchangeIdToken(ctx context.Context, request ExchangeIdTokenRequestObject) (ExchangeIdTokenResponseObject, error)
+	// Exchange the credentials of the request for a short-lived bearer token.
+	// (POST /auth/token)
+	CreateToken(ctx context.Context, request CreateTokenRequestObject) (CreateTokenResponseObject, error)
+	// Get the health status of the TODOs application
+	// (GET /healthz)
+	GetHealthZ(ctx context.Context, request GetHealthZRequestObject) (GetHealthZResponseObject, error)
+	// Register a new user.
+	// (POST /users)
+	CreateUser(ctx context.Context, request CreateUserRequestObject) (CreateUserResponseObject, error)
+	// Get the authenticated user.
+	// (GET /users/me)
+	GetCurrentUser(ctx context.Context, request GetCurrentUserRequestObject) (GetCurrentUserResponseObject, error)
+	// List the API keys of the authenticated user.
+	// (GET /users/me/api-keys)
+	ListApiKeys(ctx context.Context, request ListApiKeysRequestObject) (ListApiKeysResponseObject, error)
+	// Create an API key for the authenticated user.
+	// (POST /users/me/api-keys)
+	CreateApiKey(ctx context.Context, request CreateApiKeyRequestObject) (CreateApiKeyResponseObject, error)
+	// Revoke an API key of the authenticated user.
+	// (DELETE /users/me/api-keys/{keyId})
+	DeleteApiKey(ctx context.Context, request DeleteApiKeyRequestObject) (DeleteApiKeyResponseObject, error)
+	// Delete a workspace and all of its TODOs. The Public workspace cannot be deleted.
+	// (DELETE /workspace/{workspaceId})
+	DeleteWorkspace(ctx context.Context, request DeleteWorkspaceRequestObject) (DeleteWorkspaceResponseObject, error)
+	// Get a workspace by id.
+	// (GET /workspace/{workspaceId})
+	GetWorkspace(ctx context.Context, request GetWorkspaceRequestObject) (GetWorkspaceResponseObject, error)
+	// Stream the changes to the TODOs in the workspace.
+	// (GET /workspace/{workspaceId}/events)
+	StreamTodoEvents(ctx context.Context, request StreamTodoEventsRequestObject) (StreamTodoEventsResponseObject, error)
+	// Export every TODO in the workspace.
+	// (GET /workspace/{workspaceId}/export)
+	ExportTodos(ctx context.Context, request ExportTodosRequestObject) (ExportTodosResponseObject, error)
+	// List the groups in the workspace along with their TODO counts.
+	// (GET /workspace/{workspaceId}/groups)
+	ListGroups(ctx context.Context, request ListGroupsRequestObject) (ListGroupsResponseObject, error)
+	// Create a new group in the workspace.
+	// (POST /workspace/{workspaceId}/groups)
+	CreateGroup(ctx context.Context, request CreateGroupRequestObject) (CreateGroupResponseObject, error)
+	// Delete a group and all of the TODOs in it.
+	// (DELETE /workspace/{workspaceId}/groups/{groupId})
+	DeleteGroup(ctx context.Context, request DeleteGroupRequestObject) (DeleteGroupResponseObject, error)
+	// Get a group by id.
+	// (GET /workspace/{workspaceId}/groups/{groupId})
+	GetGroup(ctx context.Context, request GetGroupRequestObject) (GetGroupResponseObject, error)
+	// Rename a group or move its id counter forward.
+	// (PATCH /workspace/{workspaceId}/groups/{groupId})
+	UpdateGroup(ctx context.Context, request UpdateGroupRequestObject) (UpdateGroupResponseObject, error)
+	// Import TODOs into the workspace.
+	// (POST /workspace/{workspaceId}/import)
+	ImportTodos(ctx context.Context, request ImportTodosRequestObject) (ImportTodosResponseObject, error)
+	// List the members of the workspace.
+	// (GET /workspace/{workspaceId}/members)
+	ListWorkspaceMembers(ctx context.Context, request ListWorkspaceMembersRequestObject) (ListWorkspaceMembersResponseObject, error)
+	// Remove a member from the workspace.
+	// (DELETE /workspace/{workspaceId}/members/{userId})
+	RemoveWorkspaceMember(ctx context.Context, request RemoveWorkspaceMemberRequestObject) (RemoveWorkspaceMemberResponseObject, error)
+	// Add a user as a member of the workspace or change the roles of a member.
+	// (PUT /workspace/{workspaceId}/members/{userId})
+	SetWorkspaceMember(ctx context.Context, request SetWorkspaceMemberRequestObject) (SetWorkspaceMemberResponseObject, error)
+	// List the service accounts of the workspace.
+	// (GET /workspace/{workspaceId}/service-accounts)
+	ListServiceAccounts(ctx context.Context, request ListServiceAccountsRequestObject) (ListServiceAccountsResponseObject, error)
+	// Create a service account owned by the workspace.
+	// (POST /workspace/{workspaceId}/service-accounts)
+	CreateServiceAccount(ctx context.Context, request CreateServiceAccountRequestObject) (CreateServiceAccountResponseObject, error)
+	// Delete a service account along with its tokens.
+	// (DELETE /workspace/{workspaceId}/service-accounts/{accountId})
+	DeleteServiceAccount(ctx context.Context, request DeleteServiceAccountRequestObject) (DeleteServiceAccountResponseObject, error)
+	// List the tokens of a service account, including the revoked and expired tokens.
+	// (GET /workspace/{workspaceId}/service-accounts/{accountId}/tokens)
+	ListServiceAccountTokens(ctx context.Context, request ListServiceAccountTokensRequestObject) (ListServiceAccountTokensResponseObject, error)
+	// Issue a token to a service account.
+	// (POST /workspace/{workspaceId}/service-accounts/{accountId}/tokens)
+	CreateServiceAccountToken(ctx context.Context, request CreateServiceAccountTokenRequestObject) (CreateServiceAccountTokenResponseObject, error)
+	// Revoke a token of a service account.
+	// (DELETE /workspace/{workspaceId}/service-accounts/{accountId}/tokens/{tokenId})
+	RevokeServiceAccountToken(ctx context.Context, request RevokeServiceAccountTokenRequestObject) (RevokeServiceAccountTokenResponseObject, error)
+	// List TODOs in the current workspace.
+	// (GET /workspace/{workspaceId}/todos)
+	ListTodos(ctx context.Context, request ListTodosRequestObject) (ListTodosResponseObject, error)
+	// Create a new TODO in the workspace.
+	// (POST /workspace/{workspaceId}/todos)
+	CreateTodo(ctx context.Context, request CreateTodoRequestObject) (CreateTodoResponseObject, error)
+	// Delete a TODO item by id, moving it to the trash of the workspace.
+	// (DELETE /workspace/{workspaceId}/todos/{todoId})
+	DeleteTodo(ctx context.Context, request DeleteTodoRequestObject) (DeleteTodoResponseObject, error)
+	// Get a TODO item by id.
+	// (GET /workspace/{workspaceId}/todos/{todoId})
+	GetTodo(ctx context.Context, request GetTodoRequestObject) (GetTodoResponseObject, error)
+	// Update the title, details, or status of a TODO item.
+	// (PATCH /workspace/{workspaceId}/todos/{todoId})
+	UpdateTodo(ctx context.Context, request UpdateTodoRequestObject) (UpdateTodoResponseObject, error)
+	// Replace the title, details, status, assignee and watchers of a TODO item.
+	// (PUT /workspace/{workspaceId}/todos/{todoId})
+	ReplaceTodo(ctx context.Context, request ReplaceTodoRequestObject) (ReplaceTodoResponseObject, error)
+	// List every revision of a TODO item, including TODOs in the trash.
+	// (GET /workspace/{workspaceId}/todos/{todoId}/history)
+	GetTodoHistory(ctx context.Context, request GetTodoHistoryRequestObject) (GetTodoHistoryResponseObject, error)
+	// Create, update, and delete many TODOs at once.
+	// (POST /workspace/{workspaceId}/todos:batch)
+	BatchTodos(ctx context.Context, request BatchTodosRequestObject) (BatchTodosResponseObject, error)
+	// List the deleted TODOs in the trash of the workspace.
+	// (GET /workspace/{workspaceId}/trash)
+	ListTrash(ctx context.Context, request ListTrashRequestObject) (ListTrashResponseObject, error)
+	// Restore a deleted TODO from the trash with its original id.
+	// (POST /workspace/{workspaceId}/trash/{todoId}/restore)
+	RestoreTodo(ctx context.Context, request RestoreTodoRequestObject) (RestoreTodoResponseObject, error)
+	// Get the TODO status workflow of the workspace.
+	// (GET /workspace/{workspaceId}/workflow)
+	GetWorkflow(ctx context.Context, request GetWorkflowRequestObject) (GetWorkflowResponseObject, error)
+	// Replace the TODO status workflow of the workspace.
+	// (PUT /workspace/{workspaceId}/workflow)
+	SetWorkflow(ctx context.Context, request SetWorkflowRequestObject) (SetWorkflowResponseObject, error)
+	// List the workspaces.
+	// (GET /workspaces)
+	ListWorkspaces(ctx context.Context, request ListWorkspacesRequestObject) (ListWorkspacesResponseObject, error)
+	// Create a new workspace.
+	// (POST /workspaces)
+	CreateWorkspace(ctx context.Context, request CreateWorkspaceRequestObject) (CreateWorkspaceResponseObject, error)
+}
+
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// ExchangeIdToken operation middleware
+func (sh *strictHandler) ExchangeIdToken(ctx echo.Context) error {
+	var request ExchangeIdTokenRequestObject
+
+	var body ExchangeIdTokenJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ExchangeIdToken(ctx.Request().Context(), request.(ExchangeIdTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExchangeIdToken")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ExchangeIdTokenResponseObject); ok {
+		return validResponse.VisitExchangeIdTokenResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CreateToken operation middleware
+func (sh *strictHandler) CreateToken(ctx echo.Context) error {
+	var request CreateTokenRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateToken(ctx.Request().Context(), request.(CreateTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateToken")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CreateTokenResponseObject); ok {
+		return validResponse.VisitCreateTokenResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetHealthZ operation middleware
+func (sh *strictHandler) GetHealthZ(ctx echo.Context) error {
+	var request GetHealthZRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHealthZ(ctx.Request().Context(), request.(GetHealthZRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHealthZ")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetHealthZResponseObject); ok {
+		return validResponse.VisitGetHealthZResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CreateUser operation middleware
+func (sh *strictHandler) CreateUser(ctx echo.Context) error {
+	var request CreateUserRequestObject
+
+	var body CreateUserJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateUser(ctx.Request().Context(), request.(CreateUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateUser")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CreateUserResponseObject); ok {
+		return validResponse.VisitCreateUserResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCurrentUser operation middleware
+func (sh *strictHandler) GetCurrentUser(ctx echo.Context) error {
+	var request GetCurrentUserRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCurrentUser(ctx.Request().Context(), request.(GetCurrentUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCurrentUser")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCurrentUserResponseObject); ok {
+		return validResponse.VisitGetCurrentUserResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListApiKeys operation middleware
+func (sh *strictHandler) ListApiKeys(ctx echo.Context) error {
+	var request ListApiKeysRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListApiKeys(ctx.Request().Context(), request.(ListApiKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListApiKeys")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListApiKeysResponseObject); ok {
+		return validResponse.VisitListApiKeysResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CreateApiKey operation middleware
+func (sh *strictHandler) CreateApiKey(ctx echo.Context) error {
+	var request CreateApiKeyRequestObject
+
+	var body CreateApiKeyJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateApiKey(ctx.Request().Context(), request.(CreateApiKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateApiKey")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CreateApiKeyResponseObject); ok {
+		return validResponse.VisitCreateApiKeyResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteApiKey operation middleware
+func (sh *strictHandler) DeleteApiKey(ctx echo.Context, keyId string) error {
+	var request DeleteApiKeyRequestObject
+
+	request.KeyId = keyId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApiKey(ctx.Request().Context(), request.(DeleteApiKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApiKey")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteApiKeyResponseObject); ok {
+		return validResponse.VisitDeleteApiKeyResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteWorkspace operation middleware
+func (sh *strictHandler) DeleteWorkspace(ctx echo.Context, workspaceId string) error {
+	var request DeleteWorkspaceRequestObject
+
+	request.WorkspaceId = workspaceId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteWorkspace(ctx.Request().Context(), request.(DeleteWorkspaceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteWorkspace")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteWorkspaceResponseObject); ok {
+		return validResponse.VisitDeleteWorkspaceResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetWorkspace operation middleware
+func (sh *strictHandler) GetWorkspace(ctx echo.Context, workspaceId string) error {
+	var request GetWorkspaceRequestObject
+
+	request.WorkspaceId = workspaceId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWorkspace(ctx.Request().Context(), request.(GetWorkspaceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWorkspace")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetWorkspaceResponseObject); ok {
+		return validResponse.VisitGetWorkspaceResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// StreamTodoEvents operation middleware
+func (sh *strictHandler) StreamTodoEvents(ctx echo.Context, workspaceId string, params StreamTodoEventsParams) error {
+	var request StreamTodoEventsRequestObject
+
+	request.WorkspaceId = workspaceId
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StreamTodoEvents(ctx.Request().Context(), request.(StreamTodoEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StreamTodoEvents")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(StreamTodoEventsResponseObject); ok {
+		return validResponse.VisitStreamTodoEventsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ExportTodos operation middleware
+func (sh *strictHandler) ExportTodos(ctx echo.Context, workspaceId string, params ExportTodosParams) error {
+	var request ExportTodosRequestObject
+
+	request.WorkspaceId = workspaceId
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportTodos(ctx.Request().Context(), request.(ExportTodosRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportTodos")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ExportTodosResponseObject); ok {
+		return validResponse.VisitExportTodosResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListGroups operation middleware
+func (sh *strictHandler) ListGroups(ctx echo.Context, workspaceId string) error {
+	var request ListGroupsRequestObject
+
+	request.WorkspaceId = workspaceId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListGroups(ctx.Request().Context(), request.(ListGroupsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListGroups")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListGroupsResponseObject); ok {
+		return validResponse.VisitListGroupsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CreateGroup operation middleware
+func (sh *strictHandler) CreateGroup(ctx echo.Context, workspaceId string) error {
+	var request CreateGroupRequestObject
+
+	request.WorkspaceId = workspaceId
+
+	var body CreateGroupJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateGroup(ctx.Request().Context(), request.(CreateGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CreateGroupResponseObject); ok {
+		return validResponse.VisitCreateGroupResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteGroup operation middleware
+func (sh *strictHandler) DeleteGroup(ctx echo.Context, workspaceId string, groupId string) error {
+	var request DeleteGroupRequestObject
+
+	request.WorkspaceId = workspaceId
+	request.GroupId = groupId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteGroup(ctx.Request().Context(), request.(DeleteGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteGroupResponseObject); ok {
+		return validResponse.VisitDeleteGroupResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetGroup operation middleware
+func (sh *strictHandler) GetGroup(ctx echo.Context, workspaceId string, groupId string) error {
+	var request GetGroupRequestObject
+
+	request.WorkspaceId = workspaceId
+	request.GroupId = groupId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetGroup(ctx.Request().Context(), request.(GetGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetGroupResponseObject); ok {
+		return validResponse.VisitGetGroupResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// UpdateGroup operation middleware
+func (sh *strictHandler) UpdateGroup(ctx echo.Context, workspaceId string, groupId string) error {
+	var request UpdateGroupRequestObject
+
+	request.WorkspaceId = workspaceId
+	request.GroupId = groupId
+
+	var body UpdateGroupJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateGroup(ctx.Request().Context(), request.(UpdateGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(UpdateGroupResponseObject); ok {
+		return validResponse.VisitUpdateGroupResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ImportTodos operation middleware
+func (sh *strictHandler) ImportTodos(ctx echo.Context, workspaceId string, params ImportTodosParams) error {
+	var request ImportTodosRequestObject
+
+	request.WorkspaceId = workspaceId
+	request.Params = params
+
+	request.Body = ctx.Request().Body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ImportTodos(ctx.Request().Context(), request.(ImportTodosRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ImportTodos")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ImportTodosResponseObject); ok {
+		return validResponse.VisitImportTodosResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListWorkspaceMembers operation middleware
+func (sh *strictHandler) ListWorkspaceMembers(ctx echo.Context, workspaceId string) error {
+	var request ListWorkspaceMembersRequestObject
+
+	request.WorkspaceId = workspaceId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListWorkspaceMembers(ctx.Request().Context(), request.(ListWorkspaceMembersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListWorkspaceMembers")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListWorkspaceMembersResponseObject); ok {
+		return validResponse.VisitListWorkspaceMembersResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// RemoveWorkspaceMember operation middleware
+func (sh *strictHandler) RemoveWorkspaceMember(ctx echo.Context, workspaceId string, userId string) error {
+	var request RemoveWorkspaceMemberRequestObject
+
+	request.WorkspaceId = workspaceId
+	request.UserId = userId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RemoveWorkspaceMember(ctx.Request().Context(), request.(RemoveWorkspaceMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RemoveWorkspaceMember")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(RemoveWorkspaceMemberResponseObject); ok {
+		return validResponse.VisitRemoveWorkspaceMemberResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SetWorkspaceMember operation middleware
+func (sh *strictHandler) SetWorkspaceMember(ctx echo.Context, workspaceId string, userId string) error {
+	var request SetWorkspaceMemberRequestObject
+
+	request.WorkspaceId = workspaceId
+	request.UserId = userId
+
+	var body SetWorkspaceMemberJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SetWorkspaceMember(ctx.Request().Context(), request.(SetWorkspaceMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetWorkspaceMember")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SetWorkspaceMemberResponseObject); ok {
+		return validResponse.VisitSetWorkspaceMemberResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListServiceAccounts operation middleware
+func (sh *strictHandler) ListServiceAccounts(ctx echo.Context, workspaceId string) error {
+	var request ListServiceAccountsRequestObject
+
+	request.WorkspaceId = workspaceId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListServiceAccounts(ctx.Request().Context(), request.(ListServiceAccountsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListServiceAccounts")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListServiceAccountsResponseObject); ok {
+		return validResponse.VisitListServiceAccountsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CreateServiceAccount operation middleware
+func (sh *strictHandler) CreateServiceAccount(ctx echo.Context, workspaceId string) error {
+	var request CreateServiceAccountRequestObject
+
+	request.WorkspaceId = workspaceId
+
+	var body CreateServiceAccountJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateServiceAccount(ctx.Request().Context(), request.(CreateServiceAccountRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateServiceAccount")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CreateServiceAccountResponseObject); ok {
+		return validResponse.VisitCreateServiceAccountResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteServiceAccount operation middleware
+func (sh *strictHandler) DeleteServiceAccount(ctx echo.Context, workspaceId string, accountId string) error {
+	var request DeleteServiceAccountRequestObject
+
+	request.WorkspaceId = workspaceId
+	request.AccountId = accountId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteServiceAccount(ctx.Request().Context(), request.(DeleteServiceAccountRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteServiceAccount")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteServiceAccountResponseObject); ok {
+		return validResponse.VisitDeleteServiceAccountResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListServiceAccountTokens operation middleware
+func (sh *strictHandler) ListServiceAccountTokens(ctx echo.Context, workspaceId string, accountId string) error {
+	var request ListServiceAccountTokensRequestObject
+
+	request.WorkspaceId = workspaceId
+	request.AccountId = accountId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListServiceAccountTokens(ctx.Request().Context(), request.(ListServiceAccountTokensRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListServiceAccountTokens")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListServiceAccountTokensResponseObject); ok {
+		return validResponse.VisitListServiceAccountTokensResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CreateServiceAccountToken operation middleware
+func (sh *strictHandler) CreateServiceAccountToken(ctx echo.Context, workspaceId string, accountId string) error {
+	var request CreateServiceAccountTokenRequestObject
+
+	request.WorkspaceId = workspaceId
+	request.AccountId = accountId
+
+	var body CreateServiceAccountTokenJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateServiceAccountToken(ctx.Request().Context(), request.(CreateServiceAccountTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateServiceAccountToken")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CreateServiceAccountTokenResponseObject); ok {
+		return validResponse.VisitCreateServiceAccountTokenResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// RevokeServiceAccountToken operation middleware
+func (sh *strictHandler) RevokeServiceAccountToken(ctx echo.Context, workspaceId string, accountId string, tokenId string) error {
+	var request RevokeServiceAccountTokenRequestObject
+
+	request.WorkspaceId = workspaceId
+	request.AccountId = accountId
+	request.TokenId = tokenId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeServiceAccountToken(ctx.Request().Context(), request.(RevokeServiceAccountTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeServiceAccountToken")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(RevokeServiceAccountTokenResponseObject); ok {
+		return validResponse.VisitRevokeServiceAccountTokenResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListTodos operation middleware
+func (sh *strictHandler) ListTodos(ctx echo.Context, workspaceId string, params ListTodosParams) error {
+	var request ListTodosRequestObject
+
+	request.WorkspaceId = workspaceId
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTodos(ctx.Request().Context(), request.(ListTodosRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTodos")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListTodosResponseObject); ok {
+		return validResponse.VisitListTodosResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CreateTodo operation middleware
+func (sh *strictHandler) CreateTodo(ctx echo.Context, workspaceId string) error {
+	var request CreateTodoRequestObject
+
+	request.WorkspaceId = workspaceId
+
+	var body CreateTodoJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateTodo(ctx.Request().Context(), request.(CreateTodoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateTodo")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CreateTodoResponseObject); ok {
+		return validResponse.VisitCreateTodoResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteTodo operation middleware
+func (sh *strictHandler) DeleteTodo(ctx echo.Context, workspaceId string, todoId string, params DeleteTodoParams) error {
+	var request DeleteTodoRequestObject
+
+	request.WorkspaceId = workspaceId
+	request.TodoId = todoId
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTodo(ctx.Request().Context(), request.(DeleteTodoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTodo")
+	}
+
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response.Body)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteTodoResponseObject); ok {
+		return validResponse.VisitDeleteTodoResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type DeleteTodoRequestObject struct {
-	WorkspaceId string `json:"workspaceId"`
-	TodoId      string `json:"todoId"`
-}
+// GetTodo operation middleware
+func (sh *strictHandler) GetTodo(ctx echo.Context, workspaceId string, todoId string) error {
+	var request GetTodoRequestObject
 
-type DeleteTodoResponseObject interface {
-	VisitDeleteTodoResponse(w http.ResponseWriter) error
-}
+	request.WorkspaceId = workspaceId
+	request.TodoId = todoId
 
-type DeleteTodo204Response struct {
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTodo(ctx.Request().Context(), request.(GetTodoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTodo")
+	}
 
-func (response DeleteTodo204Response) VisitDeleteTodoResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTodoResponseObject); ok {
+		return validResponse.VisitGetTodoResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type DeleteTodo400JSONResponse struct {
-	StandardBadRequestProblemJSONResponse
-}
+// UpdateTodo operation middleware
+func (sh *strictHandler) UpdateTodo(ctx echo.Context, workspaceId string, todoId string, params UpdateTodoParams) error {
+	var request UpdateTodoRequestObject
 
-func (response DeleteTodo400JSONResponse) VisitDeleteTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.WorkspaceId = workspaceId
+	request.TodoId = todoId
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body UpdateTodoJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type DeleteTodo404JSONResponse struct {
-	StandardNotFoundProblemJSONResponse
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateTodo(ctx.Request().Context(), request.(UpdateTodoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateTodo")
+	}
 
-func (response DeleteTodo404JSONResponse) VisitDeleteTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(UpdateTodoResponseObject); ok {
+		return validResponse.VisitUpdateTodoResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type DeleteTododefaultJSONResponse struct {
-	Body       Problem
-	StatusCode int
-}
+// ReplaceTodo operation middleware
+func (sh *strictHandler) ReplaceTodo(ctx echo.Context, workspaceId string, todoId string, params ReplaceTodoParams) error {
+	var request ReplaceTodoRequestObject
 
-func (response DeleteTododefaultJSONResponse) VisitDeleteTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(response.StatusCode)
+	request.WorkspaceId = workspaceId
+	request.TodoId = todoId
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	var body ReplaceTodoJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type GetTodoRequestObject struct {
-	WorkspaceId string `json:"workspaceId"`
-	TodoId      string `json:"todoId"`
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ReplaceTodo(ctx.Request().Context(), request.(ReplaceTodoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReplaceTodo")
+	}
 
-type GetTodoResponseObject interface {
-	VisitGetTodoResponse(w http.ResponseWriter) error
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ReplaceTodoResponseObject); ok {
+		return validResponse.VisitReplaceTodoResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type GetTodo200JSONResponse Todo
+// GetTodoHistory operation middleware
+func (sh *strictHandler) GetTodoHistory(ctx echo.Context, workspaceId string, todoId string) error {
+	var request GetTodoHistoryRequestObject
 
-func (response GetTodo200JSONResponse) VisitGetTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.WorkspaceId = workspaceId
+	request.TodoId = todoId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTodoHistory(ctx.Request().Context(), request.(GetTodoHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTodoHistory")
+	}
 
-type GetTodo400JSONResponse struct {
-	StandardBadRequestProblemJSONResponse
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTodoHistoryResponseObject); ok {
+		return validResponse.VisitGetTodoHistoryResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-func (response GetTodo400JSONResponse) VisitGetTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// BatchTodos operation middleware
+func (sh *strictHandler) BatchTodos(ctx echo.Context, workspaceId string) error {
+	var request BatchTodosRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.WorkspaceId = workspaceId
 
-type GetTodo404JSONResponse struct {
-	StandardNotFoundProblemJSONResponse
-}
+	var body BatchTodosJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-func (response GetTodo404JSONResponse) VisitGetTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.BatchTodos(ctx.Request().Context(), request.(BatchTodosRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BatchTodos")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(ctx, request)
 
-type GetTododefaultJSONResponse struct {
-	Body       Problem
-	StatusCode int
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(BatchTodosResponseObject); ok {
+		return validResponse.VisitBatchTodosResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-func (response GetTododefaultJSONResponse) VisitGetTodoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(response.StatusCode)
+// ListTrash operation middleware
+func (sh *strictHandler) ListTrash(ctx echo.Context, workspaceId string, params ListTrashParams) error {
+	var request ListTrashRequestObject
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	request.WorkspaceId = workspaceId
+	request.Params = params
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Get the health status of the TODOs application
-	// (GET /healthz)
-	GetHealthZ(ctx context.Context, request GetHealthZRequestObject) (GetHealthZResponseObject, error)
-	// List TODOs in the current workspace.
-	// (GET /workspace/{workspaceId}/todos)
-	ListTodos(ctx context.Context, request ListTodosRequestObject) (ListTodosResponseObject, error)
-	// Create a new TODO in the workspace.
-	// (POST /workspace/{workspaceId}/todos)
-	CreateTodo(ctx context.Context, request CreateTodoRequestObject) (CreateTodoResponseObject, error)
-	// Delete a TODO item by id.
-	// (DELETE /workspace/{workspaceId}/todos/{todoId})
-	DeleteTodo(ctx context.Context, request DeleteTodoRequestObject) (DeleteTodoResponseObject, error)
-	// Get a TODO item by id.
-	// (GET /workspace/{workspaceId}/todos/{todoId})
-	GetTodo(ctx context.Context, request GetTodoRequestObject) (GetTodoResponseObject, error)
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTrash(ctx.Request().Context(), request.(ListTrashRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTrash")
+	}
 
-type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
-type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+	response, err := handler(ctx, request)
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListTrashResponseObject); ok {
+		return validResponse.VisitListTrashResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-}
+// RestoreTodo operation middleware
+func (sh *strictHandler) RestoreTodo(ctx echo.Context, workspaceId string, todoId string) error {
+	var request RestoreTodoRequestObject
 
-// GetHealthZ operation middleware
-func (sh *strictHandler) GetHealthZ(ctx echo.Context) error {
-	var request GetHealthZRequestObject
+	request.WorkspaceId = workspaceId
+	request.TodoId = todoId
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetHealthZ(ctx.Request().Context(), request.(GetHealthZRequestObject))
+		return sh.ssi.RestoreTodo(ctx.Request().Context(), request.(RestoreTodoRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetHealthZ")
+		handler = middleware(handler, "RestoreTodo")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetHealthZResponseObject); ok {
-		return validResponse.VisitGetHealthZResponse(ctx.Response())
+	} else if validResponse, ok := response.(RestoreTodoResponseObject); ok {
+		return validResponse.VisitRestoreTodoResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// ListTodos operation middleware
-func (sh *strictHandler) ListTodos(ctx echo.Context, workspaceId string, params ListTodosParams) error {
-	var request ListTodosRequestObject
+// GetWorkflow operation middleware
+func (sh *strictHandler) GetWorkflow(ctx echo.Context, workspaceId string) error {
+	var request GetWorkflowRequestObject
 
 	request.WorkspaceId = workspaceId
-	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ListTodos(ctx.Request().Context(), request.(ListTodosRequestObject))
+		return sh.ssi.GetWorkflow(ctx.Request().Context(), request.(GetWorkflowRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListTodos")
+		handler = middleware(handler, "GetWorkflow")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(ListTodosResponseObject); ok {
-		return validResponse.VisitListTodosResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetWorkflowResponseObject); ok {
+		return validResponse.VisitGetWorkflowResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// CreateTodo operation middleware
-func (sh *strictHandler) CreateTodo(ctx echo.Context, workspaceId string) error {
-	var request CreateTodoRequestObject
+// SetWorkflow operation middleware
+func (sh *strictHandler) SetWorkflow(ctx echo.Context, workspaceId string) error {
+	var request SetWorkflowRequestObject
 
 	request.WorkspaceId = workspaceId
 
-	var body CreateTodoJSONRequestBody
+	var body SetWorkflowJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateTodo(ctx.Request().Context(), request.(CreateTodoRequestObject))
+		return sh.ssi.SetWorkflow(ctx.Request().Context(), request.(SetWorkflowRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateTodo")
+		handler = middleware(handler, "SetWorkflow")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(CreateTodoResponseObject); ok {
-		return validResponse.VisitCreateTodoResponse(ctx.Response())
+	} else if validResponse, ok := response.(SetWorkflowResponseObject); ok {
+		return validResponse.VisitSetWorkflowResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteTodo operation middleware
-func (sh *strictHandler) DeleteTodo(ctx echo.Context, workspaceId string, todoId string) error {
-	var request DeleteTodoRequestObject
+// ListWorkspaces operation middleware
+func (sh *strictHandler) ListWorkspaces(ctx echo.Context, params ListWorkspacesParams) error {
+	var request ListWorkspacesRequestObject
 
-	request.WorkspaceId = workspaceId
-	request.TodoId = todoId
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteTodo(ctx.Request().Context(), request.(DeleteTodoRequestObject))
+		return sh.ssi.ListWorkspaces(ctx.Request().Context(), request.(ListWorkspacesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteTodo")
+		handler = middleware(handler, "ListWorkspaces")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteTodoResponseObject); ok {
-		return validResponse.VisitDeleteTodoResponse(ctx.Response())
+	} else if validResponse, ok := response.(ListWorkspacesResponseObject); ok {
+		return validResponse.VisitListWorkspacesResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetTodo operation middleware
-func (sh *strictHandler) GetTodo(ctx echo.Context, workspaceId string, todoId string) error {
-	var request GetTodoRequestObject
+// CreateWorkspace operation middleware
+func (sh *strictHandler) CreateWorkspace(ctx echo.Context) error {
+	var request CreateWorkspaceRequestObject
 
-	request.WorkspaceId = workspaceId
-	request.TodoId = todoId
+	var body CreateWorkspaceJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTodo(ctx.Request().Context(), request.(GetTodoRequestObject))
+		return sh.ssi.CreateWorkspace(ctx.Request().Context(), request.(CreateWorkspaceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTodo")
+		handler = middleware(handler, "CreateWorkspace")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetTodoResponseObject); ok {
-		return validResponse.VisitGetTodoResponse(ctx.Response())
+	} else if validResponse, ok := response.(CreateWorkspaceResponseObject); ok {
+		return validResponse.VisitCreateWorkspaceResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
@@ -11,17 +11,31 @@ import (
 	"net/http"
 
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/echo-middleware"
 
+	"github.com/astromechza/todo-app/backend/auth"
 	"github.com/astromechza/todo-app/backend/model"
+	"github.com/astromechza/todo-app/pkg/oidc"
 )
 
 //go:generate go run github.com/deepmap/oapi-codegen/v2/cmd/oapi-codegen --config=oapi-codegen.cfg.yaml ../api.yaml
 
 type Server struct {
 	Database model.Modelling
+	// Events is the broker that the changes made through the Database are published to.
+	Events *model.EventBroker
+	// Tokens signs the bearer tokens issued to authenticated users.
+	Tokens *auth.Signer
+	// Oidc verifies the id tokens exchanged at /auth/oidc, signing in with OpenID Connect is disabled when it is nil.
+	Oidc *oidc.Provider
+	// OidcClientId is the audience that the exchanged id tokens must be issued for.
+	OidcClientId string
+	// Registration allows anyone to register a user and receive an api key at POST /users, which is forbidden
+	// otherwise.
+	Registration bool
 }
 
 func (s *Server) GetHealthZ(ctx context.Context, _ GetHealthZRequestObject) (GetHealthZResponseObject, error) {
@@ -36,27 +50,9 @@ func DefaultErrorHandler(err error, c echo.Context) {
 	problemUri := "request-id:" + requestId
 	logger := slog.With("request-id", requestId, "method", c.Request().Method, "url", c.Request().URL.String())
 
-	if e := model.ErrBadRequest(""); errors.As(err, &e) {
-		if err = c.JSON(http.StatusBadRequest, StandardProblemResponse{
-			Type:     "about:blank",
-			Instance: &problemUri,
-			Status:   http.StatusBadRequest,
-			Title:    "Bad request",
-			Detail:   e.Error(),
-		}); err != nil {
-			logger.Warn("failed to write default error response", "err", err)
-		}
-		return
-	}
-
-	if e := model.ErrNotFound(""); errors.As(err, &e) {
-		if err = c.JSON(http.StatusNotFound, StandardProblemResponse{
-			Type:     "about:blank",
-			Instance: &problemUri,
-			Status:   http.StatusNotFound,
-			Title:    "Not found",
-			Detail:   e.Error(),
-		}); err != nil {
+	if problem, ok := modelProblem(err); ok {
+		problem.Instance = &problemUri
+		if err = c.JSON(problem.Status, problem); err != nil {
 			logger.Warn("failed to write default error response", "err", err)
 		}
 		return
@@ -102,6 +98,29 @@ func DefaultErrorHandler(err error, c echo.Context) {
 	}
 }
 
+// modelProblem returns the problem describing an error returned by the model, or false if the error is not one of
+// the model errors.
+func modelProblem(err error) (Problem, bool) {
+	problem := Problem{Type: "about:blank", Detail: err.Error()}
+	switch {
+	case errors.As(err, new(model.ErrBadRequest)):
+		problem.Status, problem.Title = http.StatusBadRequest, "Bad request"
+	case errors.As(err, new(model.ErrNotFound)):
+		problem.Status, problem.Title = http.StatusNotFound, "Not found"
+	case errors.As(err, new(model.ErrConflict)):
+		problem.Status, problem.Title = http.StatusConflict, "Conflict"
+	case errors.As(err, new(model.ErrPreconditionFailed)):
+		problem.Status, problem.Title = http.StatusPreconditionFailed, "Precondition failed"
+	case errors.As(err, new(model.ErrUnauthorized)):
+		problem.Status, problem.Title = http.StatusUnauthorized, "Unauthorized"
+	case errors.As(err, new(model.ErrForbidden)):
+		problem.Status, problem.Title = http.StatusForbidden, "Forbidden"
+	default:
+		return problem, false
+	}
+	return problem, true
+}
+
 type DefaultJsonSerializer struct {
 }
 
@@ -149,6 +168,8 @@ func BuildOpenApiValidator(raw []byte) (echo.MiddlewareFunc, error) {
 		return nil, fmt.Errorf("failed to load open api schema: %w", err)
 	}
 	validator := echomiddleware.OapiRequestValidatorWithOptions(data, &echomiddleware.Options{
+		// credentials are checked by the auth middleware rather than the validator
+		Options: openapi3filter.Options{AuthenticationFunc: openapi3filter.NoopAuthenticationFunc},
 		ErrorHandler: func(c echo.Context, err *echo.HTTPError) error {
 			switch err.Code {
 			case http.StatusBadRequest:
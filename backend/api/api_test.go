@@ -1,3 +1,907 @@
 package api
 
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+
+	"github.com/astromechza/todo-app/backend/auth"
+	"github.com/astromechza/todo-app/backend/model"
+	"github.com/astromechza/todo-app/backend/model/memmodel"
+	"github.com/astromechza/todo-app/pkg/oidc"
+)
+
 var _ StrictServerInterface = (*Server)(nil)
+
+// newTestServer builds the api server in the same way as the backend main function but over an in-memory model. The
+// configure functions can change the server before its handlers are registered.
+func newTestServer(t *testing.T, configure ...func(*Server)) *echo.Echo {
+	t.Helper()
+	spec, err := os.ReadFile("../api.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	validator, err := BuildOpenApiValidator(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := echo.New()
+	e.HTTPErrorHandler = DefaultErrorHandler
+	e.JSONSerializer = new(DefaultJsonSerializer)
+	db := memmodel.NewMemModel(model.NewPageTokenSigner(model.RandomPageTokenKey(), model.DefaultPageTokenTTL))
+	tokens := auth.NewSigner(auth.RandomKey(), auth.DefaultTokenTTL)
+	e.Use(BuildAuthMiddleware(db, tokens))
+	e.Use(validator)
+	events := model.NewEventBroker(model.DefaultEventBufferSize)
+	server := &Server{Database: model.NewPublishingModel(db, events), Events: events, Tokens: tokens, Registration: true}
+	for _, f := range configure {
+		f(server)
+	}
+	RegisterHandlers(e, NewStrictHandler(server, []StrictMiddlewareFunc{BuildRoleMiddleware()}))
+	return e
+}
+
+func doRequest(t *testing.T, e *echo.Echo, method, path, body string, into interface{}) int {
+	t.Helper()
+	return doRequestAs(t, e, "", method, path, body, into)
+}
+
+// doRequestAs sends the request with the credential as its bearer token, or anonymously if the credential is empty.
+func doRequestAs(t *testing.T, e *echo.Echo, credential, method, path, body string, into interface{}) int {
+	t.Helper()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	}
+	if credential != "" {
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+credential)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	if into != nil && rec.Body.Len() > 0 {
+		if err := json.Unmarshal(rec.Body.Bytes(), into); err != nil {
+			t.Fatalf("failed to decode response %q: %v", rec.Body.String(), err)
+		}
+	}
+	return rec.Code
+}
+
+func TestTodoLifecycle(t *testing.T) {
+	e := newTestServer(t)
+
+	var created Todo
+	if code := doRequest(t, e, http.MethodPost, "/workspace/public/todos", `{"title":"Do the thing","group_id":"OPS"}`, &created); code != http.StatusCreated {
+		t.Fatalf("unexpected create status %d", code)
+	}
+	if created.Metadata.Id != "OPS-1" || created.Status != "open" {
+		t.Fatalf("unexpected created todo %+v", created)
+	}
+
+	var updated Todo
+	if code := doRequest(t, e, http.MethodPatch, "/workspace/public/todos/OPS-1", `{"revision":0,"status":"done"}`, &updated); code != http.StatusOK {
+		t.Fatalf("unexpected update status %d", code)
+	}
+	if updated.Metadata.Revision != 1 || updated.Status != "done" {
+		t.Fatalf("unexpected updated todo %+v", updated)
+	}
+
+	var problem Problem
+	if code := doRequest(t, e, http.MethodPatch, "/workspace/public/todos/OPS-1", `{"revision":0,"title":"Stale"}`, &problem); code != http.StatusConflict {
+		t.Fatalf("unexpected stale update status %d", code)
+	}
+
+	// a put replaces every field, clearing the details that it omits
+	if code := doRequest(t, e, http.MethodPatch, "/workspace/public/todos/OPS-1", `{"revision":1,"details":"Some details"}`, &updated); code != http.StatusOK || updated.Details == nil {
+		t.Fatalf("unexpected update status %d %+v", code, updated)
+	}
+	var replaced Todo
+	if code := doRequest(t, e, http.MethodPut, "/workspace/public/todos/OPS-1", `{"revision":2,"title":"Replaced","status":"open"}`, &replaced); code != http.StatusOK {
+		t.Fatalf("unexpected replace status %d", code)
+	}
+	if replaced.Metadata.Revision != 3 || replaced.Title != "Replaced" || replaced.Status != "open" || replaced.Details != nil {
+		t.Fatalf("unexpected replaced todo %+v", replaced)
+	}
+	if code := doRequest(t, e, http.MethodPut, "/workspace/public/todos/OPS-1", `{"revision":3,"title":"No status"}`, &problem); code != http.StatusBadRequest {
+		t.Fatalf("unexpected replace status %d without a status", code)
+	}
+	var cleared Todo
+	if code := doRequest(t, e, http.MethodPatch, "/workspace/public/todos/OPS-1", `{"revision":3,"details":"Again"}`, nil); code != http.StatusOK {
+		t.Fatalf("unexpected update status %d", code)
+	}
+	if code := doRequest(t, e, http.MethodPatch, "/workspace/public/todos/OPS-1", `{"revision":4,"details":""}`, &cleared); code != http.StatusOK || cleared.Details != nil {
+		t.Fatalf("unexpected status %d clearing the details %+v", code, cleared)
+	}
+
+	if code := doRequest(t, e, http.MethodDelete, "/workspace/public/todos/OPS-1", "", nil); code != http.StatusNoContent {
+		t.Fatalf("unexpected delete status %d", code)
+	}
+	if code := doRequest(t, e, http.MethodGet, "/workspace/public/todos/OPS-1", "", &problem); code != http.StatusNotFound {
+		t.Fatalf("unexpected get status %d", code)
+	}
+}
+
+func TestInvalidRequestsAreRejected(t *testing.T) {
+	e := newTestServer(t)
+	var problem Problem
+	if code := doRequest(t, e, http.MethodPost, "/workspace/public/todos", `{"title":"x"}`, &problem); code != http.StatusBadRequest {
+		t.Fatalf("unexpected status %d", code)
+	}
+	if code := doRequest(t, e, http.MethodGet, "/workspace/missing123/todos", "", &problem); code != http.StatusUnauthorized {
+		t.Fatalf("unexpected status %d", code)
+	}
+}
+
+// newTestUser registers a user and returns its api key.
+func newTestUser(t *testing.T, e *echo.Echo, name string) CreatedUser {
+	t.Helper()
+	var created CreatedUser
+	if code := doRequest(t, e, http.MethodPost, "/users", `{"display_name":"`+name+`"}`, &created); code != http.StatusCreated || created.ApiKey.Secret == nil {
+		t.Fatalf("unexpected user create status %d", code)
+	}
+	return created
+}
+
+func TestConditionalDeleteWithETag(t *testing.T) {
+	e := newTestServer(t)
+	req := httptest.NewRequest(http.MethodPost, "/workspace/public/todos", strings.NewReader(`{"title":"Do the thing"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	created := rec.Header().Get("ETag")
+	if rec.Code != http.StatusCreated || created == "" {
+		t.Fatalf("expected an etag on create, got %d %q", rec.Code, created)
+	}
+
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/workspace/public/todos/TODO-1", nil))
+	etag := rec.Header().Get("ETag")
+	if rec.Code != http.StatusOK || etag != created {
+		t.Fatalf("expected the etag of the create on get, got %d %q", rec.Code, etag)
+	}
+	var page TodoPage
+	if doRequest(t, e, http.MethodGet, "/workspace/public/todos", "", &page); len(page.Items) != 1 || page.Items[0].Metadata.Etag == nil || *page.Items[0].Metadata.Etag != etag {
+		t.Fatalf("expected the etag in the listed metadata, got %+v", page.Items)
+	}
+	if code := doRequest(t, e, http.MethodPatch, "/workspace/public/todos/TODO-1", `{"revision":0,"title":"Changed"}`, nil); code != http.StatusOK {
+		t.Fatalf("unexpected update status %d", code)
+	}
+
+	// the body revision must agree with the If-Match header
+	req = httptest.NewRequest(http.MethodPatch, "/workspace/public/todos/TODO-1", strings.NewReader(`{"revision":1,"title":"Mismatch"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("If-Match", etag)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	if rec.Code != http.StatusPreconditionFailed {
+		t.Fatalf("expected 412 for a body revision that differs from If-Match, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	for header, expected := range map[string]int{
+		etag:                 http.StatusPreconditionFailed,
+		"W/" + etag:          http.StatusPreconditionFailed,
+		etag + `, "foreign"`: http.StatusPreconditionFailed,
+		"nonsense":           http.StatusBadRequest,
+		etag + ", nonsense":  http.StatusBadRequest,
+		`"unterminated`:      http.StatusBadRequest,
+		"":                   http.StatusBadRequest,
+		"   ":                http.StatusBadRequest,
+	} {
+		req := httptest.NewRequest(http.MethodDelete, "/workspace/public/todos/TODO-1", nil)
+		req.Header.Set("If-Match", header)
+		rec = httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		if rec.Code != expected {
+			t.Fatalf("expected %d for If-Match %q, got %d: %s", expected, header, rec.Code, rec.Body.String())
+		}
+	}
+
+	// any tag of a list may match, and "*" matches any current todo
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/workspace/public/todos/TODO-1", nil))
+	req = httptest.NewRequest(http.MethodPatch, "/workspace/public/todos/TODO-1", strings.NewReader(`{"revision":1,"title":"Listed"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("If-Match", etag+", W/\"weak\", "+rec.Header().Get("ETag"))
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected an update with a matching tag in the list to succeed, got %d: %s", rec.Code, rec.Body.String())
+	}
+	req = httptest.NewRequest(http.MethodPatch, "/workspace/public/todos/TODO-1", strings.NewReader(`{"revision":2,"title":"Any"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("If-Match", "*")
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected an update with If-Match * to succeed, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	req = httptest.NewRequest(http.MethodDelete, "/workspace/public/todos/TODO-1", nil)
+	req.Header.Set("If-Match", rec.Header().Get("ETag"))
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("expected delete with the current etag to succeed, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestBatchTodos(t *testing.T) {
+	e := newTestServer(t)
+
+	var problem Problem
+	if code := doRequest(t, e, http.MethodPost, "/workspace/public/todos:batch", `{"operations":[
+		{"op":"create","create":{"title":"First"}},
+		{"op":"update","todo_id":"TODO-1","update":{"revision":3,"title":"Stale"}}
+	]}`, &problem); code != http.StatusConflict || !strings.HasPrefix(problem.Detail, "operation 1: ") {
+		t.Fatalf("unexpected failed batch response %d %+v", code, problem)
+	}
+
+	// an operation may only have the fields of its kind
+	for _, operation := range []string{
+		`{"op":"create","todo_id":"TODO-1","create":{"title":"First"}}`,
+		`{"op":"update","todo_id":"TODO-1","create":{"title":"First"},"update":{"revision":0}}`,
+		`{"op":"delete","todo_id":"TODO-1","update":{"revision":0}}`,
+		`{"op":"update","todo_id":"TODO-1","revision":0,"update":{"revision":0}}`,
+	} {
+		if code := doRequest(t, e, http.MethodPost, "/workspace/public/todos:batch", `{"operations":[`+operation+`]}`, &problem); code != http.StatusBadRequest {
+			t.Errorf("unexpected status %d for %s", code, operation)
+		}
+	}
+
+	var batch TodoBatchResults
+	if code := doRequest(t, e, http.MethodPost, "/workspace/public/todos:batch", `{"partial":true,"operations":[
+		{"op":"create","create":{"title":"First"}},
+		{"op":"update","todo_id":"TODO-1","update":{"revision":0,"status":"done"}},
+		{"op":"delete","todo_id":"TODO-9"},
+		{"op":"delete","todo_id":"TODO-1","revision":1}
+	]}`, &batch); code != http.StatusOK {
+		t.Fatalf("unexpected partial batch status %d", code)
+	}
+	statuses := make([]int, len(batch.Results))
+	for i, result := range batch.Results {
+		statuses[i] = result.Status
+	}
+	if fmt.Sprint(statuses) != "[201 200 404 204]" || batch.Results[0].Todo.Metadata.Id != "TODO-1" || batch.Results[2].Problem == nil {
+		t.Fatalf("unexpected partial batch results %+v", batch.Results)
+	}
+}
+
+func TestExportAndImport(t *testing.T) {
+	e := newTestServer(t)
+	importer := newTestUser(t, e, "Importer")
+	key := *importer.ApiKey.Secret
+	people := `"assignee":"` + importer.User.Id + `","watchers":["` + importer.User.Id + `"]`
+	for _, body := range []string{`{"title":"Fix the boiler","group_id":"OPS","details":"It is cold.\n\nVery cold.",` + people + `}`, `{"title":"Paint the fence"}`} {
+		if code := doRequest(t, e, http.MethodPost, "/workspace/public/todos", body, nil); code != http.StatusCreated {
+			t.Fatalf("unexpected create status %d", code)
+		}
+	}
+	if code := doRequest(t, e, http.MethodPatch, "/workspace/public/todos/OPS-1", `{"revision":0,"status":"done"}`, nil); code != http.StatusOK {
+		t.Fatalf("unexpected update status %d", code)
+	}
+
+	exports := make(map[string]string)
+	for i, format := range []string{"jsonl", "csv", "markdown"} {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/workspace/public/export?format="+format, nil))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("unexpected %s export status %d: %s", format, rec.Code, rec.Body.String())
+		}
+		export := rec.Body.String()
+		exports[format] = export
+
+		// into an empty workspace the ids are kept
+		workspaceId := fmt.Sprintf("import%d", i)
+		if code := doRequestAs(t, e, key, http.MethodPost, "/workspaces", `{"id":"`+workspaceId+`","display_name":"Import"}`, nil); code != http.StatusCreated {
+			t.Fatalf("unexpected workspace create status %d", code)
+		}
+		req := httptest.NewRequest(http.MethodPost, "/workspace/"+workspaceId+"/import?format="+format, strings.NewReader(export))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEOctetStream)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+key)
+		rec = httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		var result TodoImport
+		if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil || rec.Code != http.StatusOK {
+			t.Fatalf("unexpected %s import response %d: %s", format, rec.Code, rec.Body.String())
+		}
+		if result.Imported != 2 || len(result.Renumbered) != 0 {
+			t.Errorf("unexpected %s import result %+v", format, result)
+		}
+		var imported Todo
+		if code := doRequestAs(t, e, key, http.MethodGet, "/workspace/"+workspaceId+"/todos/OPS-1", "", &imported); code != http.StatusOK {
+			t.Fatalf("unexpected get status %d", code)
+		}
+		if imported.Status != "done" || imported.Title != "Fix the boiler" || imported.Details == nil || *imported.Details != "It is cold.\n\nVery cold." {
+			t.Errorf("unexpected %s imported todo %+v", format, imported)
+		}
+		// the markdown format does not carry the assignee and watchers
+		if format == "markdown" && (imported.Assignee != nil || imported.Watchers != nil) {
+			t.Errorf("unexpected people on the markdown imported todo %+v", imported)
+		} else if format != "markdown" && (imported.Assignee == nil || *imported.Assignee != importer.User.Id || imported.Watchers == nil || fmt.Sprint(*imported.Watchers) != "["+importer.User.Id+"]") {
+			t.Errorf("expected the %s imported todo to keep its people, got %+v", format, imported)
+		}
+	}
+
+	// the assignee and watchers must be members of the workspace that the todos are imported into
+	outsiderKey := *newTestUser(t, e, "Outsider").ApiKey.Secret
+	if code := doRequestAs(t, e, outsiderKey, http.MethodPost, "/workspaces", `{"id":"outsider","display_name":"Outsider"}`, nil); code != http.StatusCreated {
+		t.Fatalf("unexpected workspace create status %d", code)
+	}
+	for _, format := range []string{"jsonl", "csv"} {
+		req := httptest.NewRequest(http.MethodPost, "/workspace/outsider/import?format="+format, strings.NewReader(exports[format]))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEOctetStream)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+outsiderKey)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		if rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), "is not a member of the workspace") {
+			t.Errorf("unexpected %s import of non-members %d: %s", format, rec.Code, rec.Body.String())
+		}
+	}
+
+	// into the same workspace the ids are taken, so the todos are renumbered
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/workspace/public/export", nil))
+	req := httptest.NewRequest(http.MethodPost, "/workspace/public/import", strings.NewReader(rec.Body.String()))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEOctetStream)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	var result TodoImport
+	if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil || rec.Code != http.StatusOK {
+		t.Fatalf("unexpected import response %d: %s", rec.Code, rec.Body.String())
+	}
+	if fmt.Sprint(result.Renumbered) != "[{OPS-1 OPS-2} {TODO-1 TODO-2}]" {
+		t.Errorf("unexpected renumbering %+v", result.Renumbered)
+	}
+}
+
+func TestMarkdownExportAndImport(t *testing.T) {
+	e := newTestServer(t)
+	key := *newTestUser(t, e, "Importer").ApiKey.Secret
+	importMarkdown := func(workspaceId string, body string, into interface{}) int {
+		req := httptest.NewRequest(http.MethodPost, "/workspace/"+workspaceId+"/import?format=markdown", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEOctetStream)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+key)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		if err := json.Unmarshal(rec.Body.Bytes(), into); err != nil {
+			t.Fatalf("failed to decode import response %d: %s", rec.Code, rec.Body.String())
+		}
+		return rec.Code
+	}
+	for _, id := range []string{"hostile", "stages", "flatflow"} {
+		if code := doRequestAs(t, e, key, http.MethodPost, "/workspaces", `{"id":"`+id+`","display_name":"Import"}`, nil); code != http.StatusCreated {
+			t.Fatalf("unexpected workspace create status %d", code)
+		}
+	}
+
+	// titles and details that look like markdown structure survive a round trip
+	title := "Fix \\n the ## boiler\n## EVIL\n- [x] EVIL-1 [done] injected"
+	details := "## Not a group\n- [ ] not an item\n\n    indented \\ text"
+	body, _ := json.Marshal(map[string]string{"title": title, "details": details, "group_id": "OPS"})
+	if code := doRequestAs(t, e, key, http.MethodPost, "/workspace/hostile/todos", string(body), nil); code != http.StatusCreated {
+		t.Fatalf("unexpected create status %d", code)
+	}
+	if code := doRequestAs(t, e, key, http.MethodPatch, "/workspace/hostile/todos/OPS-1", `{"revision":0,"status":"done"}`, nil); code != http.StatusOK {
+		t.Fatalf("unexpected update status %d", code)
+	}
+	req := httptest.NewRequest(http.MethodGet, "/workspace/hostile/export?format=markdown", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer "+key)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), "- [x] OPS-1 [done] ") {
+		t.Fatalf("unexpected export %d: %s", rec.Code, rec.Body.String())
+	}
+
+	// a checked item without a status is given the terminal status of the workflow
+	if code := doRequestAs(t, e, key, http.MethodPut, "/workspace/stages/workflow", `{"initial_status":"todo","statuses":["todo","finished"],"transitions":[]}`, nil); code != http.StatusOK {
+		t.Fatalf("unexpected workflow status %d", code)
+	}
+	var result TodoImport
+	if code := importMarkdown("stages", strings.ReplaceAll(rec.Body.String(), "OPS-1 [done] ", ""), &result); code != http.StatusOK || result.Imported != 1 {
+		t.Fatalf("unexpected import %d %+v", code, result)
+	}
+	var page TodoPage
+	if code := doRequestAs(t, e, key, http.MethodGet, "/workspace/stages/todos", "", &page); code != http.StatusOK || len(page.Items) != 1 {
+		t.Fatalf("unexpected list status %d %+v", code, page)
+	}
+	if imported := page.Items[0]; imported.Title != title || imported.Details == nil || *imported.Details != details || imported.Status != "finished" {
+		t.Errorf("unexpected imported todo %+v", imported)
+	}
+
+	// a checked item is rejected when the workflow has no terminal status
+	if code := doRequestAs(t, e, key, http.MethodPut, "/workspace/flatflow/workflow", `{"initial_status":"open","statuses":["open"],"transitions":[]}`, nil); code != http.StatusOK {
+		t.Fatalf("unexpected workflow status %d", code)
+	}
+	var problem Problem
+	if code := importMarkdown("flatflow", "- [x] Checked item\n", &problem); code != http.StatusBadRequest {
+		t.Errorf("unexpected import status %d %+v", code, problem)
+	}
+}
+
+// readEvent reads the next Server-Sent Event that is not a comment or retry field and returns its fields.
+func readEvent(t *testing.T, r *bufio.Reader) map[string]string {
+	t.Helper()
+	fields := make(map[string]string)
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			t.Fatalf("failed to read event: %v", err)
+		}
+		if line = strings.TrimSuffix(line, "\n"); line == "" {
+			if _, ok := fields["event"]; ok {
+				return fields
+			}
+			continue
+		}
+		if name, value, ok := strings.Cut(line, ": "); ok && name != "" {
+			fields[name] = value
+		}
+	}
+}
+
+func TestTodoEvents(t *testing.T) {
+	var server *Server
+	e := newTestServer(t, func(s *Server) { server = s })
+	srv := httptest.NewServer(e)
+	defer srv.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	subscribe := func(workspaceId, credential, lastEventId string) *bufio.Reader {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/workspace/"+workspaceId+"/events", nil)
+		if lastEventId != "" {
+			req.Header.Set("Last-Event-ID", lastEventId)
+		}
+		if credential != "" {
+			req.Header.Set(echo.HeaderAuthorization, "Bearer "+credential)
+		}
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { _ = res.Body.Close() })
+		if res.StatusCode != http.StatusOK || res.Header.Get(echo.HeaderContentType) != "text/event-stream" {
+			t.Fatalf("unexpected event stream response %d %s", res.StatusCode, res.Header.Get(echo.HeaderContentType))
+		}
+		return bufio.NewReader(res.Body)
+	}
+	stream := subscribe("public", "", "")
+
+	if code := doRequest(t, e, http.MethodPost, "/workspace/public/todos", `{"title":"Do the thing"}`, nil); code != http.StatusCreated {
+		t.Fatalf("unexpected create status %d", code)
+	}
+	if code := doRequest(t, e, http.MethodDelete, "/workspace/public/todos/TODO-1", "", nil); code != http.StatusNoContent {
+		t.Fatalf("unexpected delete status %d", code)
+	}
+	created, deleted := readEvent(t, stream), readEvent(t, stream)
+	var data TodoEvent
+	if err := json.Unmarshal([]byte(created["data"]), &data); err != nil || created["event"] != "created" || data.Todo.Metadata.Id != "TODO-1" {
+		t.Fatalf("unexpected created event %v", created)
+	}
+	if err := json.Unmarshal([]byte(deleted["data"]), &data); err != nil || deleted["event"] != "deleted" || data.Todo.Metadata.Revision != 1 || data.Todo.Metadata.DeletedAt == nil {
+		t.Fatalf("unexpected deleted event %v", deleted)
+	}
+
+	// resuming from the created event replays the delete
+	if replayed := readEvent(t, subscribe("public", "", created["id"])); replayed["id"] != deleted["id"] || replayed["event"] != "deleted" {
+		t.Errorf("unexpected replayed event %v", replayed)
+	}
+	// a cursor from another stream cannot be resumed
+	if reset := readEvent(t, subscribe("public", "", "unknown-1")); reset["event"] != "reset" || reset["id"] != deleted["id"] {
+		t.Errorf("unexpected reset event %v", reset)
+	}
+	if code := doRequest(t, e, http.MethodGet, "/workspace/missing1/events", "", nil); code != http.StatusUnauthorized {
+		t.Errorf("unexpected status %d for a private workspace", code)
+	}
+
+	// the active todos of a deleted group are published as deleted, while purging the trash publishes nothing
+	for _, body := range []string{`{"title":"Grouped","group_id":"OPS"}`, `{"title":"Grouped too","group_id":"OPS"}`} {
+		if code := doRequest(t, e, http.MethodPost, "/workspace/public/todos", body, nil); code != http.StatusCreated {
+			t.Fatalf("unexpected create status %d", code)
+		}
+	}
+	if code := doRequest(t, e, http.MethodDelete, "/workspace/public/groups/OPS", "", nil); code != http.StatusNoContent {
+		t.Fatalf("unexpected group delete status %d", code)
+	}
+	if purged, err := server.Database.PurgeTrash(ctx, time.Now().Add(time.Minute)); err != nil || purged != 1 {
+		t.Fatalf("unexpected purge %d %v", purged, err)
+	}
+	if code := doRequest(t, e, http.MethodPost, "/workspace/public/todos", `{"title":"After the purge"}`, nil); code != http.StatusCreated {
+		t.Fatalf("unexpected create status %d", code)
+	}
+	var events []string
+	for len(events) < 5 {
+		event := readEvent(t, stream)
+		if err := json.Unmarshal([]byte(event["data"]), &data); err != nil {
+			t.Fatal(err)
+		}
+		events = append(events, event["event"]+" "+data.Todo.Metadata.Id)
+	}
+	if expected := "[created OPS-1 created OPS-2 deleted OPS-1 deleted OPS-2 created TODO-2]"; fmt.Sprint(events) != expected {
+		t.Errorf("unexpected events %v, expected %v", events, expected)
+	}
+
+	// the subscriptions to a deleted workspace are closed
+	key := *newTestUser(t, e, "Alice").ApiKey.Secret
+	if code := doRequestAs(t, e, key, http.MethodPost, "/workspaces", `{"id":"events1","display_name":"Events"}`, nil); code != http.StatusCreated {
+		t.Fatalf("unexpected workspace create status %d", code)
+	}
+	private := subscribe("events1", key, "")
+	if code := doRequestAs(t, e, key, http.MethodDelete, "/workspace/events1", "", nil); code != http.StatusNoContent {
+		t.Fatalf("unexpected workspace delete status %d", code)
+	}
+	if _, err := io.ReadAll(private); err != nil {
+		t.Errorf("expected the stream of the deleted workspace to end, got %v", err)
+	}
+}
+
+func TestWorkspaceAuthorization(t *testing.T) {
+	e := newTestServer(t)
+	alice, bob := newTestUser(t, e, "Alice"), newTestUser(t, e, "Bob")
+	aliceKey, bobKey := *alice.ApiKey.Secret, *bob.ApiKey.Secret
+
+	if code := doRequest(t, e, http.MethodPost, "/workspaces", `{"id":"private1","display_name":"Private"}`, nil); code != http.StatusUnauthorized {
+		t.Fatalf("unexpected anonymous workspace create status %d", code)
+	}
+	if code := doRequestAs(t, e, aliceKey, http.MethodPost, "/workspaces", `{"id":"private1","display_name":"Private"}`, nil); code != http.StatusCreated {
+		t.Fatalf("unexpected workspace create status %d", code)
+	}
+
+	// the public workspace stays open, the private one needs a member
+	if code := doRequest(t, e, http.MethodGet, "/workspace/public/todos", "", nil); code != http.StatusOK {
+		t.Errorf("unexpected anonymous public status %d", code)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/workspace/private1/todos", nil))
+	if rec.Code != http.StatusUnauthorized || rec.Header().Get(echo.HeaderWWWAuthenticate) == "" {
+		t.Errorf("unexpected anonymous private response %d %v", rec.Code, rec.Header())
+	}
+	var problem Problem
+	if code := doRequestAs(t, e, bobKey, http.MethodGet, "/workspace/private1/todos", "", &problem); code != http.StatusForbidden {
+		t.Errorf("unexpected non-member status %d", code)
+	}
+	if code := doRequestAs(t, e, "tdk_forged", http.MethodGet, "/workspace/public/todos", "", nil); code != http.StatusUnauthorized {
+		t.Errorf("unexpected invalid key status %d", code)
+	}
+	var page WorkspacePage
+	if doRequestAs(t, e, bobKey, http.MethodGet, "/workspaces", "", &page); len(page.Items) != 1 {
+		t.Errorf("expected only the public workspace to be listed, got %+v", page.Items)
+	}
+
+	// an added member may access the workspace with a bearer token exchanged for their api key
+	if code := doRequestAs(t, e, aliceKey, http.MethodPut, "/workspace/private1/members/"+bob.User.Id, `{"role":"viewer"}`, nil); code != http.StatusOK {
+		t.Fatalf("unexpected add member status %d", code)
+	}
+	var token AccessToken
+	if code := doRequestAs(t, e, bobKey, http.MethodPost, "/auth/token", "", &token); code != http.StatusOK || token.TokenType != "Bearer" {
+		t.Fatalf("unexpected token status %d", code)
+	}
+	if code := doRequestAs(t, e, token.AccessToken, http.MethodGet, "/workspace/private1/todos", "", nil); code != http.StatusOK {
+		t.Errorf("unexpected member status %d", code)
+	}
+	if code := doRequestAs(t, e, token.AccessToken+"x", http.MethodGet, "/workspace/private1/todos", "", nil); code != http.StatusUnauthorized {
+		t.Errorf("unexpected tampered token status %d", code)
+	}
+
+	if code := doRequestAs(t, e, aliceKey, http.MethodDelete, "/workspace/private1/members/"+bob.User.Id, "", nil); code != http.StatusNoContent {
+		t.Fatalf("unexpected remove member status %d", code)
+	}
+	if code := doRequestAs(t, e, aliceKey, http.MethodDelete, "/workspace/private1/members/"+alice.User.Id, "", nil); code != http.StatusBadRequest {
+		t.Errorf("unexpected status %d removing the last owner", code)
+	}
+	if code := doRequestAs(t, e, bobKey, http.MethodGet, "/workspace/private1/todos", "", nil); code != http.StatusForbidden {
+		t.Errorf("unexpected removed member status %d", code)
+	}
+}
+
+func TestWorkspaceRoles(t *testing.T) {
+	e := newTestServer(t)
+	alice, bob := newTestUser(t, e, "Alice"), newTestUser(t, e, "Bob")
+	aliceKey, bobKey := *alice.ApiKey.Secret, *bob.ApiKey.Secret
+	if code := doRequestAs(t, e, aliceKey, http.MethodPost, "/workspaces", `{"id":"roles1","display_name":"Roles"}`, nil); code != http.StatusCreated {
+		t.Fatalf("unexpected workspace create status %d", code)
+	}
+	var member WorkspaceMember
+	if code := doRequestAs(t, e, aliceKey, http.MethodPut, "/workspace/roles1/members/"+bob.User.Id, `{"role":"viewer","group_roles":{"OPS":"editor"}}`, &member); code != http.StatusOK || member.Role != Viewer {
+		t.Fatalf("unexpected set member status %d %+v", code, member)
+	}
+
+	// a viewer may read but only change the todos of the group they are an editor of
+	if code := doRequestAs(t, e, bobKey, http.MethodGet, "/workspace/roles1/todos", "", nil); code != http.StatusOK {
+		t.Errorf("unexpected viewer list status %d", code)
+	}
+	if code := doRequestAs(t, e, bobKey, http.MethodPost, "/workspace/roles1/todos", `{"title":"not allowed"}`, nil); code != http.StatusForbidden {
+		t.Errorf("unexpected viewer create status %d", code)
+	}
+	if code := doRequestAs(t, e, bobKey, http.MethodPost, "/workspace/roles1/groups", `{"id":"OPS","display_name":"Operations"}`, nil); code != http.StatusCreated {
+		t.Errorf("unexpected group editor create group status %d", code)
+	}
+	if code := doRequestAs(t, e, bobKey, http.MethodPost, "/workspace/roles1/groups", `{"id":"DEV"}`, nil); code != http.StatusForbidden {
+		t.Errorf("unexpected viewer create group status %d", code)
+	}
+	var todo Todo
+	if code := doRequestAs(t, e, bobKey, http.MethodPost, "/workspace/roles1/todos", `{"group_id":"OPS","title":"allowed"}`, &todo); code != http.StatusCreated {
+		t.Fatalf("unexpected group editor create status %d", code)
+	}
+	if code := doRequestAs(t, e, bobKey, http.MethodDelete, "/workspace/roles1/todos/"+todo.Metadata.Id, "", nil); code != http.StatusNoContent {
+		t.Errorf("unexpected group editor delete status %d", code)
+	}
+	// the groups of an import are not known up front, so it needs the workspace role
+	req := httptest.NewRequest(http.MethodPost, "/workspace/roles1/import", strings.NewReader(`{"title":"imported","group":{"id":"OPS"}}`+"\n"))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEOctetStream)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer "+bobKey)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("unexpected group editor import status %d", rec.Code)
+	}
+	if code := doRequestAs(t, e, bobKey, http.MethodPost, "/workspace/roles1/todos:batch", `{"operations":[{"op":"create","create":{"group_id":"OPS","title":"batched"}}]}`, nil); code != http.StatusOK {
+		t.Errorf("unexpected group editor batch status %d", code)
+	}
+	if code := doRequestAs(t, e, bobKey, http.MethodPost, "/workspace/roles1/todos:batch", `{"operations":[{"op":"create","create":{"group_id":"OPS","title":"batched"}},{"op":"create","create":{"title":"not allowed"}}]}`, nil); code != http.StatusForbidden {
+		t.Errorf("unexpected mixed group batch status %d", code)
+	}
+	// the group of an update or delete is that of its todo, whatever create payload it carries
+	if code := doRequestAs(t, e, aliceKey, http.MethodPost, "/workspace/roles1/todos", `{"title":"protected"}`, nil); code != http.StatusCreated {
+		t.Fatalf("unexpected owner create status %d", code)
+	}
+	if code := doRequestAs(t, e, bobKey, http.MethodPost, "/workspace/roles1/todos:batch", `{"operations":[{"op":"delete","todo_id":"TODO-1","create":{"group_id":"OPS","title":"decoy"}}]}`, nil); code != http.StatusForbidden {
+		t.Errorf("unexpected decoy batch status %d", code)
+	}
+	if code := doRequestAs(t, e, aliceKey, http.MethodGet, "/workspace/roles1/todos/TODO-1", "", nil); code != http.StatusOK {
+		t.Errorf("unexpected status %d for the todo after the decoy batch", code)
+	}
+	if code := doRequestAs(t, e, bobKey, http.MethodPut, "/workspace/roles1/members/"+bob.User.Id, `{"role":"owner"}`, nil); code != http.StatusForbidden {
+		t.Errorf("unexpected viewer set member status %d", code)
+	}
+
+	// the last owner must hand over ownership before giving up their role
+	if code := doRequestAs(t, e, aliceKey, http.MethodPut, "/workspace/roles1/members/"+alice.User.Id, `{"role":"editor"}`, nil); code != http.StatusBadRequest {
+		t.Errorf("unexpected status %d demoting the last owner", code)
+	}
+	if code := doRequestAs(t, e, aliceKey, http.MethodPut, "/workspace/roles1/members/"+bob.User.Id, `{"role":"owner"}`, nil); code != http.StatusOK {
+		t.Fatalf("unexpected promote status %d", code)
+	}
+	if code := doRequestAs(t, e, aliceKey, http.MethodPut, "/workspace/roles1/members/"+alice.User.Id, `{"role":"editor"}`, nil); code != http.StatusOK {
+		t.Errorf("unexpected demote status %d", code)
+	}
+	if code := doRequestAs(t, e, aliceKey, http.MethodDelete, "/workspace/roles1", "", nil); code != http.StatusForbidden {
+		t.Errorf("unexpected editor delete workspace status %d", code)
+	}
+}
+
+func TestTodoAssignees(t *testing.T) {
+	e := newTestServer(t)
+	alice, bob, carol := newTestUser(t, e, "Alice"), newTestUser(t, e, "Bob"), newTestUser(t, e, "Carol")
+	aliceKey, bobKey := *alice.ApiKey.Secret, *bob.ApiKey.Secret
+	if code := doRequestAs(t, e, aliceKey, http.MethodPost, "/workspaces", `{"id":"assign1","display_name":"Assignees"}`, nil); code != http.StatusCreated {
+		t.Fatalf("unexpected workspace create status %d", code)
+	}
+	if code := doRequestAs(t, e, aliceKey, http.MethodPut, "/workspace/assign1/members/"+bob.User.Id, `{"role":"editor"}`, nil); code != http.StatusOK {
+		t.Fatalf("unexpected add member status %d", code)
+	}
+
+	var todo Todo
+	body := fmt.Sprintf(`{"title":"assigned","assignee":%q,"watchers":[%q]}`, bob.User.Id, alice.User.Id)
+	if code := doRequestAs(t, e, aliceKey, http.MethodPost, "/workspace/assign1/todos", body, &todo); code != http.StatusCreated || todo.Assignee == nil || *todo.Assignee != bob.User.Id || todo.Watchers == nil || len(*todo.Watchers) != 1 {
+		t.Fatalf("unexpected create status %d %+v", code, todo)
+	}
+	if code := doRequestAs(t, e, aliceKey, http.MethodPost, "/workspace/assign1/todos", `{"title":"unassigned"}`, nil); code != http.StatusCreated {
+		t.Fatalf("unexpected create status %d", code)
+	}
+	var problem Problem
+	body = fmt.Sprintf(`{"revision":0,"assignee":%q}`, carol.User.Id)
+	if code := doRequestAs(t, e, aliceKey, http.MethodPatch, "/workspace/assign1/todos/"+todo.Metadata.Id, body, &problem); code != http.StatusBadRequest || !strings.Contains(problem.Detail, "not a member") {
+		t.Errorf("unexpected status %d assigning a non-member %+v", code, problem)
+	}
+
+	list := func(credential, query string) (int, []string) {
+		var page TodoPage
+		code := doRequestAs(t, e, credential, http.MethodGet, "/workspace/assign1/todos?"+query, "", &page)
+		titles := make([]string, len(page.Items))
+		for i, item := range page.Items {
+			titles[i] = item.Title
+		}
+		return code, titles
+	}
+	if code, titles := list(bobKey, "assignee=me"); code != http.StatusOK || fmt.Sprint(titles) != "[assigned]" {
+		t.Errorf("unexpected todos assigned to bob %d %v", code, titles)
+	}
+	if code, titles := list(aliceKey, "assignee=me"); code != http.StatusOK || len(titles) != 0 {
+		t.Errorf("unexpected todos assigned to alice %d %v", code, titles)
+	}
+	if code, titles := list(aliceKey, "assignee=none"); code != http.StatusOK || fmt.Sprint(titles) != "[unassigned]" {
+		t.Errorf("unexpected unassigned todos %d %v", code, titles)
+	}
+	if code := doRequest(t, e, http.MethodGet, "/workspace/public/todos?assignee=me", "", nil); code != http.StatusUnauthorized {
+		t.Errorf("unexpected anonymous assignee=me status %d", code)
+	}
+
+	// an empty assignee unassigns the todo
+	var unassigned Todo
+	if code := doRequestAs(t, e, bobKey, http.MethodPatch, "/workspace/assign1/todos/"+todo.Metadata.Id, `{"revision":0,"assignee":""}`, &unassigned); code != http.StatusOK || unassigned.Assignee != nil {
+		t.Errorf("unexpected unassign status %d %+v", code, unassigned)
+	}
+}
+
+// TestWorkspaceOperationsDeclareScopes ensures that no operation on a workspace is left unrestricted by the role
+// middleware because its scopes were forgotten in api.yaml.
+func TestWorkspaceOperationsDeclareScopes(t *testing.T) {
+	raw, err := os.ReadFile("../api.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec, err := openapi3.NewLoader().LoadFromData(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for path, item := range spec.Paths {
+		if !strings.Contains(path, "{workspaceId}") {
+			continue
+		}
+		for method, operation := range item.Operations() {
+			scoped := false
+			if operation.Security != nil {
+				for _, requirement := range *operation.Security {
+					if scopes, ok := requirement["bearerAuth"]; ok && len(scopes) > 0 {
+						scoped = true
+						for _, scope := range scopes {
+							if _, ok := model.ScopeRoles[scope]; !ok {
+								t.Errorf("%s %s requires unknown scope %s", method, path, scope)
+							}
+						}
+					}
+				}
+			}
+			if !scoped {
+				t.Errorf("%s %s does not declare any scopes", method, path)
+			}
+		}
+	}
+}
+
+func TestServiceAccountTokens(t *testing.T) {
+	e := newTestServer(t)
+	alice := newTestUser(t, e, "Alice")
+	aliceKey := *alice.ApiKey.Secret
+	for _, id := range []string{"robots1", "robots2"} {
+		if code := doRequestAs(t, e, aliceKey, http.MethodPost, "/workspaces", `{"id":"`+id+`","display_name":"Robots"}`, nil); code != http.StatusCreated {
+			t.Fatalf("unexpected workspace create status %d", code)
+		}
+	}
+	var account ServiceAccount
+	if code := doRequestAs(t, e, aliceKey, http.MethodPost, "/workspace/robots1/service-accounts", `{"display_name":"CI"}`, &account); code != http.StatusCreated {
+		t.Fatalf("unexpected service account create status %d", code)
+	}
+	tokensPath := "/workspace/robots1/service-accounts/" + account.Id + "/tokens"
+	if code := doRequestAs(t, e, aliceKey, http.MethodPost, tokensPath, `{"name":"old","scopes":["todos:read"],"expires_at":"2000-01-01T00:00:00Z"}`, nil); code != http.StatusBadRequest {
+		t.Errorf("unexpected expired token create status %d", code)
+	}
+	var token, readOnly ServiceAccountToken
+	if code := doRequestAs(t, e, aliceKey, http.MethodPost, tokensPath, `{"name":"deploy","scopes":["todos:read","todos:write"],"group_ids":["OPS"]}`, &token); code != http.StatusCreated || token.Secret == nil {
+		t.Fatalf("unexpected token create status %d %+v", code, token)
+	}
+	if code := doRequestAs(t, e, aliceKey, http.MethodPost, tokensPath, `{"name":"report","scopes":["todos:read"]}`, &readOnly); code != http.StatusCreated {
+		t.Fatalf("unexpected token create status %d", code)
+	}
+	secret := *token.Secret
+
+	// the token is limited to its scopes, its groups, and its workspace
+	if code := doRequestAs(t, e, secret, http.MethodPost, "/workspace/robots1/todos", `{"group_id":"OPS","title":"deploy it"}`, nil); code != http.StatusCreated {
+		t.Errorf("unexpected token create todo status %d", code)
+	}
+	if code := doRequestAs(t, e, secret, http.MethodPost, "/workspace/robots1/todos", `{"title":"elsewhere"}`, nil); code != http.StatusForbidden {
+		t.Errorf("unexpected token create todo in another group status %d", code)
+	}
+	if code := doRequestAs(t, e, secret, http.MethodGet, "/workspace/robots1/todos?group=OPS", "", nil); code != http.StatusOK {
+		t.Errorf("unexpected token list group status %d", code)
+	}
+
+	// each revision records the user or service account that made it
+	if code := doRequestAs(t, e, aliceKey, http.MethodPatch, "/workspace/robots1/todos/OPS-1", `{"revision":0,"title":"deployed"}`, nil); code != http.StatusOK {
+		t.Fatalf("unexpected update status %d", code)
+	}
+	var history TodoHistory
+	if code := doRequestAs(t, e, aliceKey, http.MethodGet, "/workspace/robots1/todos/OPS-1/history", "", &history); code != http.StatusOK || len(history.Items) != 2 {
+		t.Fatalf("unexpected history status %d %+v", code, history)
+	}
+	if a := history.Items[0].Actor; a == nil || a.Kind != RevisionActorKindServiceAccount || a.Id != account.Id {
+		t.Errorf("unexpected actor of the create %+v", a)
+	}
+	if a := history.Items[1].Actor; a == nil || a.Kind != RevisionActorKindUser || a.Id != alice.User.Id {
+		t.Errorf("unexpected actor of the update %+v", a)
+	}
+	if code := doRequestAs(t, e, secret, http.MethodGet, "/workspace/robots1/todos", "", nil); code != http.StatusForbidden {
+		t.Errorf("unexpected token list all status %d", code)
+	}
+	if code := doRequestAs(t, e, secret, http.MethodPost, "/workspace/robots1/todos:batch", `{"operations":[{"op":"update","todo_id":"TODO-1","create":{"group_id":"OPS","title":"decoy"},"update":{"revision":0}}]}`, nil); code != http.StatusForbidden {
+		t.Errorf("unexpected token decoy batch status %d", code)
+	}
+	if code := doRequestAs(t, e, *readOnly.Secret, http.MethodPost, "/workspace/robots1/todos", `{"title":"read only"}`, nil); code != http.StatusForbidden {
+		t.Errorf("unexpected read only token create status %d", code)
+	}
+	if code := doRequestAs(t, e, secret, http.MethodGet, "/workspace/robots2/todos?group=OPS", "", nil); code != http.StatusForbidden {
+		t.Errorf("unexpected token other workspace status %d", code)
+	}
+	if code := doRequestAs(t, e, secret, http.MethodGet, tokensPath, "", nil); code != http.StatusForbidden {
+		t.Errorf("unexpected token admin status %d", code)
+	}
+	if code := doRequestAs(t, e, secret, http.MethodGet, "/users/me", "", nil); code != http.StatusUnauthorized {
+		t.Errorf("unexpected token current user status %d", code)
+	}
+
+	var list ServiceAccountTokenList
+	if code := doRequestAs(t, e, aliceKey, http.MethodGet, tokensPath, "", &list); code != http.StatusOK || len(list.Items) != 2 {
+		t.Fatalf("unexpected token list status %d %+v", code, list)
+	}
+	for _, item := range list.Items {
+		if item.Secret != nil || (item.Id == token.Id && item.LastUsedAt == nil) {
+			t.Errorf("unexpected listed token %+v", item)
+		}
+	}
+	if code := doRequestAs(t, e, aliceKey, http.MethodDelete, tokensPath+"/"+token.Id, "", nil); code != http.StatusNoContent {
+		t.Fatalf("unexpected revoke status %d", code)
+	}
+	if code := doRequestAs(t, e, secret, http.MethodGet, "/workspace/robots1/todos?group=OPS", "", nil); code != http.StatusUnauthorized {
+		t.Errorf("unexpected revoked token status %d", code)
+	}
+}
+
+func TestExchangeIdToken(t *testing.T) {
+	var mock *oidc.MockProvider
+	idp := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mock.ServeHTTP(w, r)
+	}))
+	defer idp.Close()
+	var err error
+	if mock, err = oidc.NewMockProvider(idp.URL); err != nil {
+		t.Fatal(err)
+	}
+	if code := doRequest(t, newTestServer(t), http.MethodPost, "/auth/oidc", `{"id_token":"x"}`, nil); code != http.StatusBadRequest {
+		t.Errorf("unexpected status %d when oidc is not configured", code)
+	}
+	e := newTestServer(t, func(s *Server) {
+		s.Oidc = oidc.NewProvider(idp.URL, nil)
+		s.OidcClientId = "todo-app"
+		s.Registration = false
+	})
+	var problem Problem
+	if code := doRequest(t, e, http.MethodPost, "/users", `{"display_name":"Mallory"}`, &problem); code != http.StatusForbidden {
+		t.Errorf("unexpected status %d registering while registration is disabled", code)
+	}
+
+	idToken := func(clientId, username string) string {
+		out, err := mock.IssueIdToken(clientId, username, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return `{"id_token":"` + out + `"}`
+	}
+	var first, second AccessToken
+	if code := doRequest(t, e, http.MethodPost, "/auth/oidc", idToken("todo-app", "alice"), &first); code != http.StatusOK {
+		t.Fatalf("unexpected exchange status %d", code)
+	}
+	if code := doRequest(t, e, http.MethodPost, "/auth/oidc", idToken("todo-app", "alice"), &second); code != http.StatusOK {
+		t.Fatalf("unexpected exchange status %d", code)
+	}
+	var user1, user2 User
+	if code := doRequestAs(t, e, first.AccessToken, http.MethodGet, "/users/me", "", &user1); code != http.StatusOK || user1.DisplayName != "alice" {
+		t.Fatalf("unexpected current user status %d %+v", code, user1)
+	}
+	if code := doRequestAs(t, e, second.AccessToken, http.MethodGet, "/users/me", "", &user2); code != http.StatusOK || user2.Id != user1.Id {
+		t.Errorf("expected the second sign in to be the same user, got %+v and %+v", user1, user2)
+	}
+	if code := doRequest(t, e, http.MethodPost, "/auth/oidc", idToken("another-client", "alice"), nil); code != http.StatusUnauthorized {
+		t.Errorf("unexpected status %d for a token of another client", code)
+	}
+}
@@ -0,0 +1,390 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/astromechza/todo-app/backend/auth"
+	"github.com/astromechza/todo-app/backend/model"
+	"github.com/astromechza/todo-app/pkg/oidc"
+	"github.com/astromechza/todo-app/pkg/ref"
+)
+
+type userContextKey struct{}
+
+type memberContextKey struct{}
+
+type serviceTokenContextKey struct{}
+
+// userFrom returns the authenticated user of the request, if any.
+func userFrom(ctx context.Context) (*model.User, bool) {
+	user, ok := ctx.Value(userContextKey{}).(*model.User)
+	return user, ok
+}
+
+// memberFrom returns the membership of the authenticated user in the workspace of the request, if any.
+func memberFrom(ctx context.Context) (*model.WorkspaceMember, bool) {
+	member, ok := ctx.Value(memberContextKey{}).(*model.WorkspaceMember)
+	return member, ok
+}
+
+// serviceTokenFrom returns the service account token of a request to the workspace that owns the service account, if
+// any.
+func serviceTokenFrom(ctx context.Context) (*model.ServiceAccountToken, bool) {
+	token, ok := ctx.Value(serviceTokenContextKey{}).(*model.ServiceAccountToken)
+	return token, ok
+}
+
+// requireUser returns the authenticated user of the request or an ErrUnauthorized for an anonymous request.
+func requireUser(ctx context.Context) (*model.User, error) {
+	if user, ok := userFrom(ctx); ok {
+		return user, nil
+	}
+	return nil, model.ErrUnauthorized("this request requires the credentials of a user")
+}
+
+// authenticate resolves the user or service account token of the Authorization header. It returns neither for an
+// anonymous request and an ErrUnauthorized when credentials are given but are not valid.
+func authenticate(ctx context.Context, db model.Modelling, tokens *auth.Signer, header string) (*model.User, *model.ServiceAccountToken, error) {
+	if header == "" {
+		return nil, nil, nil
+	}
+	scheme, credential, _ := strings.Cut(header, " ")
+	if !strings.EqualFold(scheme, "Bearer") || credential == "" {
+		return nil, nil, model.ErrUnauthorized("the Authorization header must be 'Bearer' followed by an api key or token")
+	}
+	if model.IsServiceToken(credential) {
+		token, err := db.UseServiceAccountToken(ctx, credential, time.Now())
+		if errors.As(err, new(model.ErrNotFound)) {
+			return nil, nil, model.ErrUnauthorized("the service account token is not valid, or has been revoked or has expired")
+		}
+		return nil, token, err
+	}
+	if model.IsApiKey(credential) {
+		user, err := db.GetUserByApiKey(ctx, credential)
+		if errors.As(err, new(model.ErrNotFound)) {
+			return nil, nil, model.ErrUnauthorized("the api key is not valid")
+		}
+		return user, nil, err
+	}
+	claims, err := tokens.Verify(credential)
+	if err != nil {
+		return nil, nil, model.ErrUnauthorized(err.Error())
+	}
+	user, err := db.GetUser(ctx, claims.Subject)
+	if errors.As(err, new(model.ErrNotFound)) {
+		return nil, nil, model.ErrUnauthorized("the user of the bearer token no longer exists")
+	}
+	return user, nil, err
+}
+
+// BuildAuthMiddleware resolves the user or service account token of each request and denies access to any workspace
+// other than the public workspace unless the user is a member of it or the token belongs to it. It must run after
+// routing so that the workspace id path parameter is available.
+func BuildAuthMiddleware(db model.Modelling, tokens *auth.Signer) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx := c.Request().Context()
+			user, serviceToken, err := authenticate(ctx, db, tokens, c.Request().Header.Get(echo.HeaderAuthorization))
+			if err != nil {
+				c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Bearer realm="todo-app"`)
+				return err
+			}
+			if user != nil {
+				ctx = context.WithValue(ctx, userContextKey{}, user)
+				ctx = model.ContextWithActor(ctx, model.Actor{Kind: model.ActorKindUser, Id: user.Id})
+			} else if serviceToken != nil {
+				ctx = model.ContextWithActor(ctx, model.Actor{Kind: model.ActorKindServiceAccount, Id: serviceToken.ServiceAccountId})
+			}
+			if workspaceId := c.Param("workspaceId"); workspaceId != "" && workspaceId != model.SharedWorkspaceId {
+				switch {
+				case serviceToken != nil:
+					if serviceToken.WorkspaceId != workspaceId {
+						return model.ErrForbidden(fmt.Sprintf("the service account token cannot access workspace '%s'", workspaceId))
+					}
+					ctx = context.WithValue(ctx, serviceTokenContextKey{}, serviceToken)
+				case user == nil:
+					c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Bearer realm="todo-app"`)
+					return model.ErrUnauthorized(fmt.Sprintf("workspace '%s' requires credentials", workspaceId))
+				default:
+					// a workspace that does not exist is reported in the same way so that its existence is not revealed
+					member, err := db.GetWorkspaceMember(ctx, workspaceId, user.Id)
+					if errors.As(err, new(model.ErrNotFound)) {
+						return model.ErrForbidden(fmt.Sprintf("user '%s' is not a member of workspace '%s'", user.Id, workspaceId))
+					} else if err != nil {
+						return err
+					}
+					ctx = context.WithValue(ctx, memberContextKey{}, member)
+				}
+			}
+			c.SetRequest(c.Request().WithContext(ctx))
+			return next(c)
+		}
+	}
+}
+
+// requestGroupIds returns the groups that the request is limited to, or nil if it may affect any group of the
+// workspace. The groups of an import are only known once its body has been read, so an import counts as affecting
+// any group.
+func requestGroupIds(c echo.Context, request interface{}) []string {
+	if groupId := c.Param("groupId"); groupId != "" {
+		return []string{groupId}
+	} else if todoId := c.Param("todoId"); todoId != "" {
+		groupId, _ := model.SplitGroupId(todoId)
+		return []string{groupId}
+	}
+	switch r := request.(type) {
+	case CreateTodoRequestObject:
+		if r.Body != nil {
+			return []string{ref.DeRefOr(r.Body.GroupId, model.DefaultGroupId)}
+		}
+	case CreateGroupRequestObject:
+		if r.Body != nil {
+			return []string{r.Body.Id}
+		}
+	case ListTodosRequestObject:
+		if r.Params.Group != nil && len(*r.Params.Group) > 0 {
+			return *r.Params.Group
+		}
+	case BatchTodosRequestObject:
+		if r.Body == nil {
+			return nil
+		}
+		// the group is taken from the field that the kind of operation uses, the other fields are rejected by the handler
+		out := make([]string, 0, len(r.Body.Operations))
+		for _, operation := range r.Body.Operations {
+			switch {
+			case operation.Op == Create && operation.Create != nil:
+				out = append(out, ref.DeRefOr(operation.Create.GroupId, model.DefaultGroupId))
+			case (operation.Op == Update || operation.Op == Delete) && operation.TodoId != nil:
+				groupId, _ := model.SplitGroupId(*operation.TodoId)
+				out = append(out, groupId)
+			default:
+				return nil
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+// roleFor returns the role of the member for a request that affects the groups. This is their workspace role unless
+// each of the groups grants them more, so the group roles only apply to the requests that requestGroupIds can limit to
+// some groups: those on a group or its todos, creating a group, and creating, listing or batching todos.
+func roleFor(member *model.WorkspaceMember, groupIds []string) string {
+	if len(groupIds) == 0 {
+		return member.Role
+	}
+	role := member.RoleIn(groupIds[0])
+	for _, groupId := range groupIds[1:] {
+		if groupRole := member.RoleIn(groupId); !model.RoleAllows(groupRole, role) {
+			role = groupRole
+		}
+	}
+	return role
+}
+
+// BuildRoleMiddleware enforces the scopes declared for each operation in api.yaml. A member must have a role in the
+// groups that the request affects that grants every scope of the operation, while a service account token must have
+// been issued with the scopes and for the groups. It relies on BuildAuthMiddleware to resolve the member or token, so
+// requests to the public workspace or without a workspace are not restricted.
+func BuildRoleMiddleware() StrictMiddlewareFunc {
+	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+		return func(c echo.Context, request interface{}) (interface{}, error) {
+			member, isMember := memberFrom(c.Request().Context())
+			serviceToken, isServiceToken := serviceTokenFrom(c.Request().Context())
+			if !isMember && !isServiceToken {
+				return f(c, request)
+			}
+			groupIds := requestGroupIds(c, request)
+			scopes, _ := c.Get(BearerAuthScopes).([]string)
+			for _, scope := range scopes {
+				required, ok := model.ScopeRoles[scope]
+				if !ok {
+					return nil, fmt.Errorf("operation %s requires unknown scope '%s'", operationID, scope)
+				}
+				if isMember {
+					if role := roleFor(member, groupIds); !model.RoleAllows(role, required) {
+						return nil, model.ErrForbidden(fmt.Sprintf("the %s role of user '%s' does not grant '%s'", role, member.UserId, scope))
+					}
+				} else if !slices.Contains(serviceToken.Scopes, scope) {
+					return nil, model.ErrForbidden(fmt.Sprintf("the service account token is not granted '%s'", scope))
+				}
+			}
+			if isServiceToken && !serviceToken.AllowsGroups(groupIds) {
+				return nil, model.ErrForbidden(fmt.Sprintf("the service account token is limited to the groups %s", strings.Join(serviceToken.GroupIds, ", ")))
+			}
+			return f(c, request)
+		}
+	}
+}
+
+func toApiUser(item *model.User) User {
+	return User{Id: item.Id, DisplayName: item.DisplayName, CreatedAt: item.EpochAt}
+}
+
+func toApiApiKey(item *model.ApiKey) ApiKey {
+	out := ApiKey{Id: item.Id, Name: item.Name, CreatedAt: item.CreatedAt}
+	if item.Secret != "" {
+		out.Secret = &item.Secret
+	}
+	return out
+}
+
+func (s *Server) CreateUser(ctx context.Context, request CreateUserRequestObject) (CreateUserResponseObject, error) {
+	if !s.Registration {
+		return nil, model.ErrForbidden("registration is disabled on this server")
+	}
+	user, err := s.Database.CreateUser(ctx, model.CreateUsersParams{Id: request.Body.Id, DisplayName: request.Body.DisplayName})
+	if err != nil {
+		return nil, err
+	}
+	key, err := s.Database.CreateApiKey(ctx, user.Id, model.CreateApiKeysParams{Name: "default"})
+	if err != nil {
+		return nil, err
+	}
+	return CreateUser201JSONResponse{User: toApiUser(user), ApiKey: toApiApiKey(key)}, nil
+}
+
+func (s *Server) GetCurrentUser(ctx context.Context, request GetCurrentUserRequestObject) (GetCurrentUserResponseObject, error) {
+	user, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return GetCurrentUser200JSONResponse(toApiUser(user)), nil
+}
+
+func (s *Server) ListApiKeys(ctx context.Context, request ListApiKeysRequestObject) (ListApiKeysResponseObject, error) {
+	user, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := s.Database.ListApiKeys(ctx, user.Id)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]ApiKey, len(keys))
+	for i, key := range keys {
+		out[i] = toApiApiKey(&key)
+	}
+	return ListApiKeys200JSONResponse{Items: out}, nil
+}
+
+func (s *Server) CreateApiKey(ctx context.Context, request CreateApiKeyRequestObject) (CreateApiKeyResponseObject, error) {
+	user, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	key, err := s.Database.CreateApiKey(ctx, user.Id, model.CreateApiKeysParams{Name: request.Body.Name})
+	if err != nil {
+		return nil, err
+	}
+	return CreateApiKey201JSONResponse(toApiApiKey(key)), nil
+}
+
+func (s *Server) DeleteApiKey(ctx context.Context, request DeleteApiKeyRequestObject) (DeleteApiKeyResponseObject, error) {
+	user, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Database.DeleteApiKey(ctx, user.Id, request.KeyId); err != nil {
+		return nil, err
+	}
+	return DeleteApiKey204Response{}, nil
+}
+
+func (s *Server) CreateToken(ctx context.Context, request CreateTokenRequestObject) (CreateTokenResponseObject, error) {
+	user, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	token, expires, err := s.Tokens.Issue(user.Id)
+	if err != nil {
+		return nil, err
+	}
+	return CreateToken200JSONResponse{AccessToken: token, TokenType: "Bearer", ExpiresAt: expires}, nil
+}
+
+func (s *Server) ExchangeIdToken(ctx context.Context, request ExchangeIdTokenRequestObject) (ExchangeIdTokenResponseObject, error) {
+	if s.Oidc == nil {
+		return nil, model.ErrBadRequest("signing in with OpenID Connect is not configured")
+	}
+	claims, err := s.Oidc.Verify(ctx, request.Body.IdToken, s.OidcClientId)
+	if errors.Is(err, oidc.ErrInvalidToken) {
+		return nil, model.ErrUnauthorized(err.Error())
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to verify id token: %w", err)
+	}
+	identity := model.UserIdentity{Issuer: claims.Issuer, Subject: claims.Subject}
+	user, err := s.Database.GetUserByIdentity(ctx, identity)
+	if errors.As(err, new(model.ErrNotFound)) {
+		// the display name is limited to the same length as the display name of a user registered at /users
+		displayName := []rune(claims.DisplayName())
+		displayName = displayName[:min(len(displayName), 200)]
+		user, err = s.Database.CreateUser(ctx, model.CreateUsersParams{DisplayName: string(displayName), Identity: &identity})
+		// another sign in of the same identity may have created the user in the meantime
+		if errors.As(err, new(model.ErrConflict)) {
+			user, err = s.Database.GetUserByIdentity(ctx, identity)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	token, expires, err := s.Tokens.Issue(user.Id)
+	if err != nil {
+		return nil, err
+	}
+	return ExchangeIdToken200JSONResponse{AccessToken: token, TokenType: "Bearer", ExpiresAt: expires}, nil
+}
+
+func toApiWorkspaceMember(item *model.WorkspaceMember) WorkspaceMember {
+	out := WorkspaceMember{UserId: item.UserId, AddedAt: item.AddedAt, Role: Role(item.Role)}
+	if len(item.GroupRoles) > 0 {
+		groupRoles := make(GroupRoles, len(item.GroupRoles))
+		for groupId, role := range item.GroupRoles {
+			groupRoles[groupId] = Role(role)
+		}
+		out.GroupRoles = &groupRoles
+	}
+	return out
+}
+
+func (s *Server) ListWorkspaceMembers(ctx context.Context, request ListWorkspaceMembersRequestObject) (ListWorkspaceMembersResponseObject, error) {
+	members, err := s.Database.ListWorkspaceMembers(ctx, request.WorkspaceId)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]WorkspaceMember, len(members))
+	for i, member := range members {
+		out[i] = toApiWorkspaceMember(&member)
+	}
+	return ListWorkspaceMembers200JSONResponse{Items: out}, nil
+}
+
+func (s *Server) SetWorkspaceMember(ctx context.Context, request SetWorkspaceMemberRequestObject) (SetWorkspaceMemberResponseObject, error) {
+	params := model.SetWorkspaceMemberParams{Role: string(request.Body.Role)}
+	if request.Body.GroupRoles != nil {
+		params.GroupRoles = make(map[string]string, len(*request.Body.GroupRoles))
+		for groupId, role := range *request.Body.GroupRoles {
+			params.GroupRoles[groupId] = string(role)
+		}
+	}
+	member, err := s.Database.SetWorkspaceMember(ctx, request.WorkspaceId, request.UserId, params)
+	if err != nil {
+		return nil, err
+	}
+	return SetWorkspaceMember200JSONResponse(toApiWorkspaceMember(member)), nil
+}
+
+func (s *Server) RemoveWorkspaceMember(ctx context.Context, request RemoveWorkspaceMemberRequestObject) (RemoveWorkspaceMemberResponseObject, error) {
+	if _, err := s.Database.RemoveWorkspaceMember(ctx, request.WorkspaceId, request.UserId); err != nil {
+		return nil, err
+	}
+	return RemoveWorkspaceMember204Response{}, nil
+}
@@ -0,0 +1,93 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/astromechza/todo-app/backend/model"
+)
+
+// todoETag returns the strong entity tag of the todo. The epoch identifies this lifecycle of the todo id and the
+// revision identifies the version within it, so the pair changes whenever the todo does.
+func todoETag(item *model.Todo) string {
+	return fmt.Sprintf(`"%d-%d"`, item.Epoch, item.Revision)
+}
+
+// entityTag is the epoch and revision of a todo ETag.
+type entityTag struct {
+	epoch    int64
+	revision int64
+}
+
+// parseIfMatch parses an If-Match header holding "*" or a comma separated list of entity tags as defined by RFC 9110.
+// A missing header or a "*" returns a nil list since they do not constrain the epoch or revision of an existing todo,
+// while an empty header is malformed.
+// If-Match uses the strong comparison, so weak tags and tags that were not issued by this api are valid but never
+// match, which leaves an empty list.
+func parseIfMatch(header *string) ([]entityTag, error) {
+	if header == nil || strings.TrimSpace(*header) == "*" {
+		return nil, nil
+	}
+	invalid := model.ErrBadRequest("If-Match must be '*' or a list of entity tags")
+	out := make([]entityTag, 0, 1)
+	raw := strings.TrimSpace(*header)
+	if raw == "" {
+		return nil, invalid
+	}
+	for raw != "" {
+		weak := strings.HasPrefix(raw, "W/")
+		raw = strings.TrimPrefix(raw, "W/")
+		if !strings.HasPrefix(raw, `"`) {
+			return nil, invalid
+		}
+		end := strings.IndexByte(raw[1:], '"')
+		if end < 0 {
+			return nil, invalid
+		}
+		opaque := raw[1 : end+1]
+		raw = strings.TrimSpace(raw[end+2:])
+		if raw != "" {
+			if raw[0] != ',' {
+				return nil, invalid
+			}
+			raw = strings.TrimSpace(raw[1:])
+		}
+
+		rawEpoch, rawRevision, _ := strings.Cut(opaque, "-")
+		epoch, epochErr := strconv.ParseInt(rawEpoch, 10, 64)
+		revision, revisionErr := strconv.ParseInt(rawRevision, 10, 64)
+		if !weak && epochErr == nil && revisionErr == nil {
+			out = append(out, entityTag{epoch: epoch, revision: revision})
+		}
+	}
+	return out, nil
+}
+
+// resolveIfMatch returns the epoch and revision that the todo must still have for the If-Match header to be met, or
+// nil values when the header does not constrain it. A list of several tags is resolved against the current todo, the
+// caller passes the result to the model so that the match is still checked atomically.
+func (s *Server) resolveIfMatch(ctx context.Context, workspaceId string, todoId string, header *string) (*int64, *int64, error) {
+	tags, err := parseIfMatch(header)
+	if err != nil || tags == nil {
+		return nil, nil, err
+	}
+	failed := model.ErrPreconditionFailed("todo does not match any entity tag in If-Match")
+	switch len(tags) {
+	case 0:
+		return nil, nil, failed
+	case 1:
+		return &tags[0].epoch, &tags[0].revision, nil
+	}
+	current, err := s.Database.GetTodo(ctx, workspaceId, todoId)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, tag := range tags {
+		if tag.epoch == current.Epoch && tag.revision == current.Revision {
+			return &tag.epoch, &tag.revision, nil
+		}
+	}
+	return nil, nil, failed
+}
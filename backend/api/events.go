@@ -0,0 +1,102 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/astromechza/todo-app/backend/model"
+)
+
+// eventKeepAliveInterval is how often a comment is sent on an idle event stream so that proxies keep it open.
+const eventKeepAliveInterval = 15 * time.Second
+
+// eventRetry is the reconnection delay suggested to clients of the event stream.
+const eventRetry = 3 * time.Second
+
+// eventStream encodes the events of a subscription as Server-Sent Events. It implements io.WriterTo so that io.Copy
+// writes each event as soon as it is published and flushes it to the client.
+type eventStream struct {
+	ctx       context.Context
+	sub       *model.EventSubscription
+	keepAlive *time.Ticker
+	buf       bytes.Buffer
+}
+
+// next waits for the next event or keep-alive and encodes it into the buffer. It returns io.EOF once the request is
+// cancelled or the subscription is closed.
+func (s *eventStream) next() error {
+	select {
+	case <-s.ctx.Done():
+		return io.EOF
+	case <-s.keepAlive.C:
+		s.buf.WriteString(": keep-alive\n\n")
+	case event, ok := <-s.sub.Events():
+		if !ok {
+			return io.EOF
+		}
+		data, err := json.Marshal(TodoEvent{Type: TodoEventType(event.Type), Todo: toApiTodo(&event.Todo)})
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(&s.buf, "id: %s\nevent: %s\ndata: %s\n\n", event.Cursor, event.Type, data)
+	}
+	return nil
+}
+
+func (s *eventStream) Read(p []byte) (int, error) {
+	for s.buf.Len() == 0 {
+		if err := s.next(); err != nil {
+			return 0, err
+		}
+	}
+	return s.buf.Read(p)
+}
+
+func (s *eventStream) WriteTo(w io.Writer) (int64, error) {
+	var written int64
+	for {
+		n, err := s.buf.WriteTo(w)
+		if written += n; err != nil {
+			return written, err
+		}
+		if f, ok := w.(http.Flusher); ok {
+			f.Flush()
+		}
+		if err := s.next(); err == io.EOF {
+			return written, nil
+		} else if err != nil {
+			return written, err
+		}
+	}
+}
+
+func (s *eventStream) Close() error {
+	s.keepAlive.Stop()
+	s.sub.Close()
+	return nil
+}
+
+func (s *Server) StreamTodoEvents(ctx context.Context, request StreamTodoEventsRequestObject) (StreamTodoEventsResponseObject, error) {
+	if _, err := s.Database.GetWorkspace(ctx, request.WorkspaceId); err != nil {
+		return nil, err
+	}
+	sub, err := s.Events.Subscribe(request.WorkspaceId, request.Params.LastEventID)
+	if err != nil {
+		return nil, err
+	}
+	stream := &eventStream{ctx: ctx, sub: sub, keepAlive: time.NewTicker(eventKeepAliveInterval)}
+	fmt.Fprintf(&stream.buf, "retry: %d\n\n", eventRetry.Milliseconds())
+	if sub.Reset {
+		// the id moves the client past the missed events so that it is not reset again when it next reconnects
+		fmt.Fprintf(&stream.buf, "id: %s\nevent: reset\ndata: {}\n\n", sub.Cursor)
+	}
+	return StreamTodoEvents200TexteventStreamResponse{
+		Body:    stream,
+		Headers: StreamTodoEvents200ResponseHeaders{CacheControl: "no-cache"},
+	}, nil
+}
@@ -0,0 +1,430 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/astromechza/todo-app/backend/model"
+	"github.com/astromechza/todo-app/pkg/ref"
+)
+
+// exportPageSize is the number of todos read from the model at a time while exporting.
+const exportPageSize = 500
+
+var csvHeader = []string{"id", "group_id", "status", "title", "details", "assignee", "watchers", "revision", "epoch", "created_at", "updated_at"}
+
+// todoEncoder writes todos in one of the export formats.
+type todoEncoder interface {
+	encode(todo *model.Todo) error
+	// flush writes anything that is still buffered once every todo has been encoded.
+	flush() error
+}
+
+type jsonlEncoder struct {
+	enc *json.Encoder
+}
+
+func newJsonlEncoder(w io.Writer) *jsonlEncoder {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	return &jsonlEncoder{enc: enc}
+}
+
+func (e *jsonlEncoder) encode(todo *model.Todo) error {
+	return e.enc.Encode(toApiTodo(todo))
+}
+
+func (e *jsonlEncoder) flush() error {
+	return nil
+}
+
+type csvEncoder struct {
+	w           *csv.Writer
+	wroteHeader bool
+}
+
+func (e *csvEncoder) encode(todo *model.Todo) error {
+	if !e.wroteHeader {
+		if err := e.w.Write(csvHeader); err != nil {
+			return err
+		}
+		e.wroteHeader = true
+	}
+	return e.w.Write([]string{
+		fmt.Sprintf("%s-%d", todo.Group.Id, todo.Id), todo.Group.Id, todo.Status, todo.Title, ref.DeRefOr(todo.Details, ""),
+		ref.DeRefOr(todo.Assignee, ""), strings.Join(todo.Watchers, " "), strconv.FormatInt(todo.Revision, 10), strconv.FormatInt(todo.Epoch, 10),
+		todo.EpochAt.Format(time.RFC3339Nano), todo.RevisionAt.Format(time.RFC3339Nano),
+	})
+}
+
+func (e *csvEncoder) flush() error {
+	if !e.wroteHeader {
+		if err := e.w.Write(csvHeader); err != nil {
+			return err
+		}
+	}
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// markdownEscaper escapes the title of a checklist item so that it stays on the line of the item.
+var markdownEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, "\r", `\r`)
+
+// markdownUnescapes maps the character after a backslash to the character that markdownEscaper escaped.
+var markdownUnescapes = map[byte]byte{'\\': '\\', 'n': '\n', 'r': '\r'}
+
+// unescapeMarkdown reverses markdownEscaper. Any other backslash is kept as it is.
+func unescapeMarkdown(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			if c, ok := markdownUnescapes[s[i+1]]; ok {
+				sb.WriteByte(c)
+				i++
+				continue
+			}
+		}
+		sb.WriteByte(s[i])
+	}
+	return sb.String()
+}
+
+// markdownEncoder writes a checklist with a heading for each group. The todos must be ordered by group. Todos in the
+// terminal status of the workflow are checked, the terminal status is empty if the workflow has none.
+type markdownEncoder struct {
+	w              io.Writer
+	workspaceId    string
+	terminalStatus string
+	group          *string
+}
+
+func (e *markdownEncoder) encode(todo *model.Todo) error {
+	var sb strings.Builder
+	if e.group == nil {
+		fmt.Fprintf(&sb, "# TODOs in %s\n", e.workspaceId)
+	}
+	if e.group == nil || *e.group != todo.Group.Id {
+		fmt.Fprintf(&sb, "\n## %s\n\n", todo.Group.Id)
+		e.group = &todo.Group.Id
+	}
+	check := " "
+	if e.terminalStatus != "" && todo.Status == e.terminalStatus {
+		check = "x"
+	}
+	fmt.Fprintf(&sb, "- [%s] %s-%d [%s] %s\n", check, todo.Group.Id, todo.Id, todo.Status, markdownEscaper.Replace(todo.Title))
+	// the details are indented under the item so that none of their lines can be read as an item or heading
+	if todo.Details != nil {
+		for _, line := range strings.Split(*todo.Details, "\n") {
+			sb.WriteString(strings.TrimRight("    "+line, " ") + "\n")
+		}
+	}
+	_, err := io.WriteString(e.w, sb.String())
+	return err
+}
+
+func (e *markdownEncoder) flush() error {
+	if e.group == nil {
+		_, err := fmt.Fprintf(e.w, "# TODOs in %s\n", e.workspaceId)
+		return err
+	}
+	return nil
+}
+
+// exportTodos encodes the first page and every following page of todos.
+func (s *Server) exportTodos(ctx context.Context, workspaceId string, page *model.ListTodosPage, encoder todoEncoder) error {
+	for {
+		for i := range page.Items {
+			if err := encoder.encode(&page.Items[i]); err != nil {
+				return err
+			}
+		}
+		if page.NextPageToken == nil {
+			return encoder.flush()
+		}
+		var err error
+		if page, err = s.Database.ListTodos(ctx, workspaceId, model.ListTodosParams{PageToken: page.NextPageToken, PageSize: ref.Ref(exportPageSize)}); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) ExportTodos(ctx context.Context, request ExportTodosRequestObject) (ExportTodosResponseObject, error) {
+	// the first page is read before responding so that a missing workspace is reported as a problem
+	page, err := s.Database.ListTodos(ctx, request.WorkspaceId, model.ListTodosParams{PageSize: ref.Ref(exportPageSize)})
+	if err != nil {
+		return nil, err
+	}
+
+	reader, writer := io.Pipe()
+	var encoder todoEncoder
+	var response ExportTodosResponseObject
+	switch ref.DeRefOr(request.Params.Format, ExportTodosParamsFormatJsonl) {
+	case ExportTodosParamsFormatCsv:
+		encoder, response = &csvEncoder{w: csv.NewWriter(writer)}, ExportTodos200TextcsvResponse{Body: reader}
+	case ExportTodosParamsFormatMarkdown:
+		workflow, err := s.Database.GetWorkflow(ctx, request.WorkspaceId)
+		if err != nil {
+			return nil, err
+		}
+		terminalStatus, _ := workflow.TerminalStatus()
+		encoder, response = &markdownEncoder{w: writer, workspaceId: request.WorkspaceId, terminalStatus: terminalStatus}, ExportTodos200TextmarkdownResponse{Body: reader}
+	default:
+		encoder, response = newJsonlEncoder(writer), ExportTodos200ApplicationxNdjsonResponse{Body: reader}
+	}
+	go func() {
+		_ = writer.CloseWithError(s.exportTodos(ctx, request.WorkspaceId, page, encoder))
+	}()
+	// stop the export if the client goes away before reading all of it
+	go func() {
+		<-ctx.Done()
+		_ = reader.CloseWithError(ctx.Err())
+	}()
+	return response, nil
+}
+
+var groupIdPattern = regexp.MustCompile(`^[A-Z][A-Z0-9]+$`)
+
+var userIdPattern = regexp.MustCompile(`^[A-Za-z0-9]{6,26}$`)
+
+// importedTodo is a todo read from an import along with the line it was read from, for error messages.
+type importedTodo struct {
+	line   int
+	params model.CreateTodosParams
+}
+
+// newImportedTodo validates the fields of an imported todo in the same way as the api validates a created todo. The
+// id may be empty, in which case the group id is used, and the group id may be empty too for the default group. The
+// model checks that the assignee and watchers are members of the workspace.
+func newImportedTodo(line int, id, groupId, title, details, status, assignee string, watchers []string) (importedTodo, error) {
+	out := importedTodo{line: line, params: model.CreateTodosParams{GroupId: groupId, Title: title}}
+	if id != "" {
+		idGroup, rawSerial := model.SplitGroupId(id)
+		serial, err := strconv.ParseInt(rawSerial, 10, 64)
+		if err != nil || serial < 1 || (groupId != "" && groupId != idGroup) {
+			return out, model.ErrBadRequest(fmt.Sprintf("line %d: invalid todo id '%s'", line, id))
+		}
+		out.params.GroupId, out.params.Id = idGroup, &serial
+	}
+	if out.params.GroupId == "" {
+		out.params.GroupId = model.DefaultGroupId
+	} else if !groupIdPattern.MatchString(out.params.GroupId) {
+		return out, model.ErrBadRequest(fmt.Sprintf("line %d: invalid group id '%s'", line, out.params.GroupId))
+	}
+	if n := utf8.RuneCountInString(title); n < 3 || n > 200 {
+		return out, model.ErrBadRequest(fmt.Sprintf("line %d: the title must be between 3 and 200 characters", line))
+	}
+	if details != "" {
+		if utf8.RuneCountInString(details) > 5000 {
+			return out, model.ErrBadRequest(fmt.Sprintf("line %d: the details must be at most 5000 characters", line))
+		}
+		out.params.Details = &details
+	}
+	if status != "" {
+		out.params.Status = &status
+	}
+	if assignee != "" {
+		if !userIdPattern.MatchString(assignee) {
+			return out, model.ErrBadRequest(fmt.Sprintf("line %d: invalid assignee '%s'", line, assignee))
+		}
+		out.params.Assignee = &assignee
+	}
+	for _, watcher := range watchers {
+		if !userIdPattern.MatchString(watcher) {
+			return out, model.ErrBadRequest(fmt.Sprintf("line %d: invalid watcher '%s'", line, watcher))
+		}
+	}
+	if len(watchers) > 0 {
+		out.params.Watchers = watchers
+	}
+	return out, nil
+}
+
+// maxImportLine is the longest line accepted in a jsonl or markdown import.
+const maxImportLine = 1 << 20
+
+func newImportScanner(r io.Reader) *bufio.Scanner {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxImportLine)
+	return scanner
+}
+
+func parseJsonlImport(r io.Reader) ([]importedTodo, error) {
+	out := make([]importedTodo, 0)
+	scanner := newImportScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		var todo Todo
+		if err := json.Unmarshal(scanner.Bytes(), &todo); err != nil {
+			return nil, model.ErrBadRequest(fmt.Sprintf("line %d: failed to parse todo: %v", line, err))
+		}
+		item, err := newImportedTodo(
+			line, todo.Metadata.Id, todo.Metadata.GroupId, todo.Title, ref.DeRefOr(todo.Details, ""), todo.Status,
+			ref.DeRefOr(todo.Assignee, ""), ref.DeRefOr(todo.Watchers, nil),
+		)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, item)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, model.ErrBadRequest(fmt.Sprintf("failed to read import: %v", err))
+	}
+	return out, nil
+}
+
+func parseCsvImport(r io.Reader) ([]importedTodo, error) {
+	reader := csv.NewReader(r)
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return make([]importedTodo, 0), nil
+	} else if err != nil {
+		return nil, model.ErrBadRequest(fmt.Sprintf("failed to read csv header: %v", err))
+	}
+	// column returns the value of the named column, which is empty if the header does not have the column
+	column := func(record []string, name string) string {
+		if i := slices.Index(header, name); i >= 0 && i < len(record) {
+			return record[i]
+		}
+		return ""
+	}
+	if !slices.Contains(header, "title") {
+		return nil, model.ErrBadRequest("the csv header must have a title column")
+	}
+	out := make([]importedTodo, 0)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return out, nil
+		} else if err != nil {
+			return nil, model.ErrBadRequest(fmt.Sprintf("failed to read csv: %v", err))
+		}
+		line, _ := reader.FieldPos(0)
+		item, err := newImportedTodo(
+			line, column(record, "id"), column(record, "group_id"), column(record, "title"), column(record, "details"), column(record, "status"),
+			column(record, "assignee"), strings.Fields(column(record, "watchers")),
+		)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, item)
+	}
+}
+
+// markdownItemPattern matches a checklist item as written by the markdown export, where the id and status are
+// optional: "- [x] GROUP-1 [done] The title".
+var markdownItemPattern = regexp.MustCompile(`^[-*] \[([ xX])\] (?:([A-Z][A-Z0-9]+-[0-9]+) )?(?:\[([a-z][a-z0-9_]{0,31})\] )?(.+)$`)
+
+// parseMarkdownImport reads a checklist. A checked item without a status is given the terminal status of the workflow.
+func parseMarkdownImport(r io.Reader, workflow *model.Workflow) ([]importedTodo, error) {
+	type item struct {
+		line                     int
+		checked                  bool
+		id, group, status, title string
+		details                  []string
+	}
+	items := make([]*item, 0)
+	var group string
+	scanner := newImportScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimRight(scanner.Text(), " \t\r")
+		switch {
+		case strings.HasPrefix(text, "## "):
+			group = strings.TrimSpace(strings.TrimPrefix(text, "## "))
+		case markdownItemPattern.MatchString(text):
+			m := markdownItemPattern.FindStringSubmatch(text)
+			items = append(items, &item{line: line, checked: m[1] != " ", id: m[2], group: group, status: m[3], title: unescapeMarkdown(m[4])})
+		case len(items) > 0 && (strings.HasPrefix(text, "    ") || text == ""):
+			// indented lines hold the details of the last item, blank lines are kept in case more details follow
+			last := items[len(items)-1]
+			last.details = append(last.details, strings.TrimPrefix(text, "    "))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, model.ErrBadRequest(fmt.Sprintf("failed to read import: %v", err))
+	}
+
+	out := make([]importedTodo, 0, len(items))
+	for _, it := range items {
+		if it.status == "" && it.checked {
+			terminalStatus, ok := workflow.TerminalStatus()
+			if !ok {
+				return nil, model.ErrBadRequest(fmt.Sprintf("line %d: the item is checked but the workspace workflow has no terminal status", it.line))
+			}
+			it.status = terminalStatus
+		}
+		group := it.group
+		if it.id != "" {
+			group = ""
+		}
+		for len(it.details) > 0 && it.details[len(it.details)-1] == "" {
+			it.details = it.details[:len(it.details)-1]
+		}
+		imported, err := newImportedTodo(it.line, it.id, group, it.title, strings.Join(it.details, "\n"), it.status, "", nil)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, imported)
+	}
+	return out, nil
+}
+
+func (s *Server) ImportTodos(ctx context.Context, request ImportTodosRequestObject) (ImportTodosResponseObject, error) {
+	var todos []importedTodo
+	var err error
+	switch ref.DeRefOr(request.Params.Format, ImportTodosParamsFormatJsonl) {
+	case ImportTodosParamsFormatCsv:
+		todos, err = parseCsvImport(request.Body)
+	case ImportTodosParamsFormatMarkdown:
+		var workflow *model.Workflow
+		if workflow, err = s.Database.GetWorkflow(ctx, request.WorkspaceId); err != nil {
+			return nil, err
+		}
+		todos, err = parseMarkdownImport(request.Body, workflow)
+	default:
+		todos, err = parseJsonlImport(request.Body)
+	}
+	if err != nil {
+		return nil, err
+	}
+	out := TodoImport{Renumbered: make([]TodoRenumbering, 0)}
+	if len(todos) == 0 {
+		return ImportTodos200JSONResponse(out), nil
+	} else if len(todos) > model.MaxBatchOperations {
+		return nil, model.ErrBadRequest(fmt.Sprintf("an import may have at most %d todos", model.MaxBatchOperations))
+	}
+
+	params := model.BatchTodosParams{Operations: make([]model.TodoOperation, len(todos))}
+	for i, todo := range todos {
+		params.Operations[i] = model.TodoOperation{Kind: model.TodoOperationCreate, Create: todo.params}
+	}
+	results, err := s.Database.BatchTodos(ctx, request.WorkspaceId, params)
+	if batchErr := (*model.BatchError)(nil); errors.As(err, &batchErr) {
+		// report the line of the todo rather than its position in the batch
+		return nil, fmt.Errorf("line %d: %w", todos[batchErr.Index].line, batchErr.Err)
+	} else if err != nil {
+		return nil, err
+	}
+	for i, result := range results {
+		if preferred := todos[i].params.Id; preferred != nil && *preferred != result.Todo.Id {
+			out.Renumbered = append(out.Renumbered, TodoRenumbering{
+				From: fmt.Sprintf("%s-%d", result.Todo.Group.Id, *preferred),
+				To:   fmt.Sprintf("%s-%d", result.Todo.Group.Id, result.Todo.Id),
+			})
+		}
+	}
+	out.Imported = len(results)
+	return ImportTodos200JSONResponse(out), nil
+}
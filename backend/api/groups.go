@@ -0,0 +1,74 @@
+package api
+
+import (
+	"context"
+
+	"github.com/astromechza/todo-app/backend/model"
+)
+
+func toApiGroup(item *model.Group) Group {
+	return Group{
+		Metadata: GroupMetadata{
+			Id:             item.Id,
+			Epoch:          int(item.Epoch),
+			WorkspaceId:    item.Workspace.Id,
+			WorkspaceEpoch: int(item.Workspace.Epoch),
+			CreatedAt:      item.EpochAt,
+		},
+		DisplayName: item.DisplayName,
+		NextSerial:  item.LastSerial + 1,
+		TodoCount:   item.TodoCount,
+	}
+}
+
+func (s *Server) GetGroup(ctx context.Context, request GetGroupRequestObject) (GetGroupResponseObject, error) {
+	res, err := s.Database.GetGroup(ctx, request.WorkspaceId, request.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	return GetGroup200JSONResponse(toApiGroup(res)), nil
+}
+
+func (s *Server) ListGroups(ctx context.Context, request ListGroupsRequestObject) (ListGroupsResponseObject, error) {
+	res, err := s.Database.ListGroups(ctx, request.WorkspaceId)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Group, len(res))
+	for i, item := range res {
+		out[i] = toApiGroup(&item)
+	}
+	return ListGroups200JSONResponse(GroupList{Items: out}), nil
+}
+
+func (s *Server) CreateGroup(ctx context.Context, request CreateGroupRequestObject) (CreateGroupResponseObject, error) {
+	params := model.CreateGroupsParams{
+		Id:          request.Body.Id,
+		DisplayName: request.Body.DisplayName,
+		NextSerial:  request.Body.NextSerial,
+	}
+	if res, err := s.Database.CreateGroup(ctx, request.WorkspaceId, params); err != nil {
+		return nil, err
+	} else {
+		return CreateGroup201JSONResponse(toApiGroup(res)), nil
+	}
+}
+
+func (s *Server) UpdateGroup(ctx context.Context, request UpdateGroupRequestObject) (UpdateGroupResponseObject, error) {
+	params := model.UpdateGroupsParams{
+		DisplayName: request.Body.DisplayName,
+		NextSerial:  request.Body.NextSerial,
+	}
+	if res, err := s.Database.UpdateGroup(ctx, request.WorkspaceId, request.GroupId, params); err != nil {
+		return nil, err
+	} else {
+		return UpdateGroup200JSONResponse(toApiGroup(res)), nil
+	}
+}
+
+func (s *Server) DeleteGroup(ctx context.Context, request DeleteGroupRequestObject) (DeleteGroupResponseObject, error) {
+	if _, err := s.Database.DeleteGroup(ctx, request.WorkspaceId, request.GroupId); err != nil {
+		return nil, err
+	}
+	return DeleteGroup204Response{}, nil
+}
@@ -0,0 +1,97 @@
+package api
+
+import (
+	"context"
+
+	"github.com/astromechza/todo-app/backend/model"
+	"github.com/astromechza/todo-app/pkg/ref"
+)
+
+func toApiServiceAccount(item *model.ServiceAccount) ServiceAccount {
+	return ServiceAccount{Id: item.Id, DisplayName: item.DisplayName, CreatedAt: item.CreatedAt}
+}
+
+func toApiServiceAccountToken(item *model.ServiceAccountToken) ServiceAccountToken {
+	out := ServiceAccountToken{
+		Id:         item.Id,
+		Name:       item.Name,
+		Scopes:     make([]Scope, len(item.Scopes)),
+		CreatedAt:  item.CreatedAt,
+		ExpiresAt:  item.ExpiresAt,
+		LastUsedAt: item.LastUsedAt,
+		RevokedAt:  item.RevokedAt,
+	}
+	for i, scope := range item.Scopes {
+		out.Scopes[i] = Scope(scope)
+	}
+	if len(item.GroupIds) > 0 {
+		out.GroupIds = &item.GroupIds
+	}
+	if item.Secret != "" {
+		out.Secret = &item.Secret
+	}
+	return out
+}
+
+func (s *Server) ListServiceAccounts(ctx context.Context, request ListServiceAccountsRequestObject) (ListServiceAccountsResponseObject, error) {
+	accounts, err := s.Database.ListServiceAccounts(ctx, request.WorkspaceId)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]ServiceAccount, len(accounts))
+	for i, account := range accounts {
+		out[i] = toApiServiceAccount(&account)
+	}
+	return ListServiceAccounts200JSONResponse{Items: out}, nil
+}
+
+func (s *Server) CreateServiceAccount(ctx context.Context, request CreateServiceAccountRequestObject) (CreateServiceAccountResponseObject, error) {
+	account, err := s.Database.CreateServiceAccount(ctx, request.WorkspaceId, model.CreateServiceAccountsParams{DisplayName: request.Body.DisplayName})
+	if err != nil {
+		return nil, err
+	}
+	return CreateServiceAccount201JSONResponse(toApiServiceAccount(account)), nil
+}
+
+func (s *Server) DeleteServiceAccount(ctx context.Context, request DeleteServiceAccountRequestObject) (DeleteServiceAccountResponseObject, error) {
+	if err := s.Database.DeleteServiceAccount(ctx, request.WorkspaceId, request.AccountId); err != nil {
+		return nil, err
+	}
+	return DeleteServiceAccount204Response{}, nil
+}
+
+func (s *Server) ListServiceAccountTokens(ctx context.Context, request ListServiceAccountTokensRequestObject) (ListServiceAccountTokensResponseObject, error) {
+	tokens, err := s.Database.ListServiceAccountTokens(ctx, request.WorkspaceId, request.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]ServiceAccountToken, len(tokens))
+	for i, token := range tokens {
+		out[i] = toApiServiceAccountToken(&token)
+	}
+	return ListServiceAccountTokens200JSONResponse{Items: out}, nil
+}
+
+func (s *Server) CreateServiceAccountToken(ctx context.Context, request CreateServiceAccountTokenRequestObject) (CreateServiceAccountTokenResponseObject, error) {
+	params := model.CreateServiceAccountTokensParams{
+		Name:      request.Body.Name,
+		Scopes:    make([]string, len(request.Body.Scopes)),
+		GroupIds:  ref.DeRefOr(request.Body.GroupIds, nil),
+		ExpiresAt: request.Body.ExpiresAt,
+	}
+	for i, scope := range request.Body.Scopes {
+		params.Scopes[i] = string(scope)
+	}
+	token, err := s.Database.CreateServiceAccountToken(ctx, request.WorkspaceId, request.AccountId, params)
+	if err != nil {
+		return nil, err
+	}
+	return CreateServiceAccountToken201JSONResponse(toApiServiceAccountToken(token)), nil
+}
+
+func (s *Server) RevokeServiceAccountToken(ctx context.Context, request RevokeServiceAccountTokenRequestObject) (RevokeServiceAccountTokenResponseObject, error) {
+	if err := s.Database.RevokeServiceAccountToken(ctx, request.WorkspaceId, request.AccountId, request.TokenId); err != nil {
+		return nil, err
+	}
+	return RevokeServiceAccountToken204Response{}, nil
+}
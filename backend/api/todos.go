@@ -3,13 +3,14 @@ package api
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/astromechza/todo-app/backend/model"
 	"github.com/astromechza/todo-app/pkg/ref"
 )
 
 func toApiTodo(item *model.Todo) Todo {
-	return Todo{
+	out := Todo{
 		Metadata: TodoMetadata{
 			Id:             fmt.Sprintf("%s-%d", item.Group.Id, item.Id),
 			Epoch:          int(item.Epoch),
@@ -20,11 +21,18 @@ func toApiTodo(item *model.Todo) Todo {
 			UpdatedAt:      item.RevisionAt,
 			GroupId:        item.Group.Id,
 			GroupEpoch:     int(item.Group.Epoch),
+			DeletedAt:      item.DeletedAt,
+			Etag:           ref.Ref(todoETag(item)),
 		},
-		Status:  item.Status,
-		Details: item.Details,
-		Title:   item.Title,
+		Status:   item.Status,
+		Details:  item.Details,
+		Title:    item.Title,
+		Assignee: item.Assignee,
 	}
+	if len(item.Watchers) > 0 {
+		out.Watchers = &item.Watchers
+	}
+	return out
 }
 
 func (s *Server) GetTodo(ctx context.Context, request GetTodoRequestObject) (GetTodoResponseObject, error) {
@@ -32,17 +40,73 @@ func (s *Server) GetTodo(ctx context.Context, request GetTodoRequestObject) (Get
 	if err != nil {
 		return nil, err
 	}
-	return GetTodo200JSONResponse(toApiTodo(res)), nil
+	return GetTodo200JSONResponse{
+		Body:    toApiTodo(res),
+		Headers: GetTodo200ResponseHeaders{ETag: todoETag(res)},
+	}, nil
+}
+
+// toModelSort combines the sort parameters of a list request, sort_updated_at is a shorthand for sorting by updated_at.
+func toModelSort(params ListTodosParams) (model.TodoSort, error) {
+	out := model.TodoSort{}
+	if params.Sort != nil {
+		out.Field = model.TodoSortField(*params.Sort)
+	}
+	if params.Order != nil {
+		out.Descending = *params.Order == ListTodosParamsOrderDesc
+	}
+	if params.SortUpdatedAt != nil {
+		if (params.Sort != nil && out.Field != model.TodoSortUpdatedAt) || (params.Order != nil && string(*params.Order) != string(*params.SortUpdatedAt)) {
+			return out, model.ErrBadRequest("sort_updated_at conflicts with the sort and order parameters")
+		}
+		out = model.TodoSort{Field: model.TodoSortUpdatedAt, Descending: *params.SortUpdatedAt == ListTodosParamsSortUpdatedAtDesc}
+	}
+	return out, nil
+}
+
+// toModelAssignee resolves the assignee filter of a list request, 'me' is the authenticated user and 'none' matches the
+// unassigned todos.
+func toModelAssignee(ctx context.Context, assignee *string) (*string, error) {
+	switch ref.DeRefOr(assignee, "") {
+	case "":
+		return nil, nil
+	case "none":
+		return ref.Ref(""), nil
+	case "me":
+		user, err := requireUser(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &user.Id, nil
+	default:
+		return assignee, nil
+	}
 }
 
 func (s *Server) ListTodos(ctx context.Context, request ListTodosRequestObject) (ListTodosResponseObject, error) {
+	sort, err := toModelSort(request.Params)
+	if err != nil {
+		return nil, err
+	}
+	assignee, err := toModelAssignee(ctx, request.Params.Assignee)
+	if err != nil {
+		return nil, err
+	}
 	params := model.ListTodosParams{
+		TodoFilter: model.TodoFilter{
+			ByGroup:       ref.DeRefOr(request.Params.Group, nil),
+			ByStatus:      ref.DeRefOr(request.Params.Status, nil),
+			ByAssignee:    assignee,
+			Query:         request.Params.Q,
+			CreatedAfter:  request.Params.CreatedAfter,
+			CreatedBefore: request.Params.CreatedBefore,
+			UpdatedAfter:  request.Params.UpdatedAfter,
+			UpdatedBefore: request.Params.UpdatedBefore,
+		},
+		Sort:      sort,
 		PageToken: request.Params.Page,
 		PageSize:  request.Params.PageSize,
 	}
-	if request.Params.Status != nil {
-		params.ByStatus = []string{*request.Params.Status}
-	}
 
 	res, err := s.Database.ListTodos(ctx, request.WorkspaceId, params)
 	if err != nil {
@@ -61,20 +125,219 @@ func (s *Server) ListTodos(ctx context.Context, request ListTodosRequestObject)
 
 func (s *Server) CreateTodo(ctx context.Context, request CreateTodoRequestObject) (CreateTodoResponseObject, error) {
 	params := model.CreateTodosParams{
-		GroupId: ref.DeRefOr(request.Body.GroupId, model.DefaultGroupId),
-		Title:   request.Body.Title,
-		Details: request.Body.Details,
+		GroupId:  ref.DeRefOr(request.Body.GroupId, model.DefaultGroupId),
+		Title:    request.Body.Title,
+		Details:  request.Body.Details,
+		Assignee: request.Body.Assignee,
+		Watchers: ref.DeRefOr(request.Body.Watchers, nil),
 	}
 	if res, err := s.Database.CreateTodo(ctx, request.WorkspaceId, params); err != nil {
 		return nil, err
 	} else {
-		return CreateTodo201JSONResponse(toApiTodo(res)), nil
+		return CreateTodo201JSONResponse{
+			Body:    toApiTodo(res),
+			Headers: CreateTodo201ResponseHeaders{ETag: todoETag(res)},
+		}, nil
 	}
 }
 
+func (s *Server) UpdateTodo(ctx context.Context, request UpdateTodoRequestObject) (UpdateTodoResponseObject, error) {
+	params := model.UpdateTodosParams{
+		Revision: int64(request.Body.Revision),
+		Title:    request.Body.Title,
+		Details:  request.Body.Details,
+		Status:   request.Body.Status,
+		Assignee: request.Body.Assignee,
+		Watchers: request.Body.Watchers,
+	}
+	res, err := s.updateTodo(ctx, request.WorkspaceId, request.TodoId, request.Params.IfMatch, params)
+	if err != nil {
+		return nil, err
+	}
+	return UpdateTodo200JSONResponse{
+		Body:    toApiTodo(res),
+		Headers: UpdateTodo200ResponseHeaders{ETag: todoETag(res)},
+	}, nil
+}
+
+// ReplaceTodo is an update of every mutable field, the optional fields that are omitted are cleared.
+func (s *Server) ReplaceTodo(ctx context.Context, request ReplaceTodoRequestObject) (ReplaceTodoResponseObject, error) {
+	params := model.UpdateTodosParams{
+		Revision: int64(request.Body.Revision),
+		Title:    &request.Body.Title,
+		Details:  ref.Ref(ref.DeRefOr(request.Body.Details, "")),
+		Status:   &request.Body.Status,
+		Assignee: ref.Ref(ref.DeRefOr(request.Body.Assignee, "")),
+		Watchers: ref.Ref(ref.DeRefOr(request.Body.Watchers, []string{})),
+	}
+	res, err := s.updateTodo(ctx, request.WorkspaceId, request.TodoId, request.Params.IfMatch, params)
+	if err != nil {
+		return nil, err
+	}
+	return ReplaceTodo200JSONResponse{
+		Body:    toApiTodo(res),
+		Headers: ReplaceTodo200ResponseHeaders{ETag: todoETag(res)},
+	}, nil
+}
+
+// updateTodo applies the If-Match header, if any, to the update.
+func (s *Server) updateTodo(ctx context.Context, workspaceId string, todoId string, ifMatch *string, params model.UpdateTodosParams) (*model.Todo, error) {
+	epoch, revision, err := s.resolveIfMatch(ctx, workspaceId, todoId, ifMatch)
+	if err != nil {
+		return nil, err
+	} else if revision != nil {
+		if *revision != params.Revision {
+			return nil, model.ErrPreconditionFailed("the revision in the body does not match the If-Match header")
+		}
+		params.Epoch = epoch
+	}
+	return s.Database.UpdateTodo(ctx, workspaceId, todoId, params)
+}
+
 func (s *Server) DeleteTodo(ctx context.Context, request DeleteTodoRequestObject) (DeleteTodoResponseObject, error) {
-	if err := s.Database.DeleteTodo(ctx, request.WorkspaceId, request.TodoId, model.DeleteTodosParams{}); err != nil {
+	epoch, revision, err := s.resolveIfMatch(ctx, request.WorkspaceId, request.TodoId, request.Params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.Database.DeleteTodo(ctx, request.WorkspaceId, request.TodoId, model.DeleteTodosParams{Epoch: epoch, Revision: revision}); err != nil {
 		return nil, err
 	}
 	return DeleteTodo204Response{}, nil
 }
+
+func (s *Server) ListTrash(ctx context.Context, request ListTrashRequestObject) (ListTrashResponseObject, error) {
+	res, err := s.Database.ListTodos(ctx, request.WorkspaceId, model.ListTodosParams{
+		TodoFilter: model.TodoFilter{Trashed: true},
+		PageToken:  request.Params.Page,
+		PageSize:   request.Params.PageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Todo, len(res.Items))
+	for i, item := range res.Items {
+		out[i] = toApiTodo(&item)
+	}
+	return ListTrash200JSONResponse(TodoPage{
+		Items:          out,
+		RemainingItems: res.RemainingItems,
+		NextPageToken:  res.NextPageToken,
+	}), nil
+}
+
+func (s *Server) RestoreTodo(ctx context.Context, request RestoreTodoRequestObject) (RestoreTodoResponseObject, error) {
+	if res, err := s.Database.RestoreTodo(ctx, request.WorkspaceId, request.TodoId); err != nil {
+		return nil, err
+	} else {
+		return RestoreTodo200JSONResponse(toApiTodo(res)), nil
+	}
+}
+
+func (s *Server) GetTodoHistory(ctx context.Context, request GetTodoHistoryRequestObject) (GetTodoHistoryResponseObject, error) {
+	res, err := s.Database.ListTodoHistory(ctx, request.WorkspaceId, request.TodoId)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]TodoRevision, len(res))
+	for i, item := range res {
+		changes := make([]TodoFieldChange, len(item.Changes))
+		for j, c := range item.Changes {
+			changes[j] = TodoFieldChange{Field: c.Field, From: c.From, To: c.To}
+		}
+		out[i] = TodoRevision{
+			Revision:  int(item.Revision),
+			UpdatedAt: item.RevisionAt,
+			Action:    TodoRevisionAction(item.Action),
+			Changes:   changes,
+		}
+		if item.Actor != nil {
+			out[i].Actor = &RevisionActor{Kind: RevisionActorKind(item.Actor.Kind), Id: item.Actor.Id}
+		}
+	}
+	return GetTodoHistory200JSONResponse(TodoHistory{Items: out}), nil
+}
+
+// checkOperationFields rejects a batch operation that sets a field used by a different kind of operation, since the
+// groups that the batch affects are resolved from the fields of the kind of each operation.
+func checkOperationFields(op TodoOperation) error {
+	var field string
+	switch {
+	case op.Op != Create && op.Create != nil:
+		field = "create"
+	case op.Op != Update && op.Update != nil:
+		field = "update"
+	case op.Op != Delete && op.Revision != nil:
+		field = "revision"
+	case op.Op == Create && op.TodoId != nil:
+		field = "todo_id"
+	default:
+		return nil
+	}
+	return model.ErrBadRequest(fmt.Sprintf("a %s operation must not have the %s field", op.Op, field))
+}
+
+func (s *Server) BatchTodos(ctx context.Context, request BatchTodosRequestObject) (BatchTodosResponseObject, error) {
+	params := model.BatchTodosParams{
+		Partial:    ref.DeRefOr(request.Body.Partial, false),
+		Operations: make([]model.TodoOperation, len(request.Body.Operations)),
+	}
+	for i, op := range request.Body.Operations {
+		out := model.TodoOperation{Kind: model.TodoOperationKind(op.Op), TodoId: ref.DeRefOr(op.TodoId, "")}
+		if err := checkOperationFields(op); err != nil {
+			return nil, &model.BatchError{Index: i, Err: err}
+		}
+		switch out.Kind {
+		case model.TodoOperationCreate:
+			if op.Create == nil {
+				return nil, &model.BatchError{Index: i, Err: model.ErrBadRequest("a create operation requires the create field")}
+			}
+			out.Create = model.CreateTodosParams{
+				GroupId:  ref.DeRefOr(op.Create.GroupId, model.DefaultGroupId),
+				Title:    op.Create.Title,
+				Details:  op.Create.Details,
+				Assignee: op.Create.Assignee,
+				Watchers: ref.DeRefOr(op.Create.Watchers, nil),
+			}
+		case model.TodoOperationUpdate:
+			if op.Update == nil {
+				return nil, &model.BatchError{Index: i, Err: model.ErrBadRequest("an update operation requires the update field")}
+			}
+			out.Update = model.UpdateTodosParams{
+				Revision: int64(op.Update.Revision),
+				Title:    op.Update.Title,
+				Details:  op.Update.Details,
+				Status:   op.Update.Status,
+				Assignee: op.Update.Assignee,
+				Watchers: op.Update.Watchers,
+			}
+		case model.TodoOperationDelete:
+			if op.Revision != nil {
+				out.Delete.Revision = ref.Ref(int64(*op.Revision))
+			}
+		}
+		params.Operations[i] = out
+	}
+
+	results, err := s.Database.BatchTodos(ctx, request.WorkspaceId, params)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]TodoOperationResult, len(results))
+	for i, result := range results {
+		switch {
+		case result.Err != nil:
+			problem, ok := modelProblem(result.Err)
+			if !ok {
+				return nil, result.Err
+			}
+			out[i] = TodoOperationResult{Status: problem.Status, Problem: &problem}
+		case params.Operations[i].Kind == model.TodoOperationDelete:
+			out[i] = TodoOperationResult{Status: http.StatusNoContent}
+		case params.Operations[i].Kind == model.TodoOperationCreate:
+			out[i] = TodoOperationResult{Status: http.StatusCreated, Todo: ref.Ref(toApiTodo(result.Todo))}
+		default:
+			out[i] = TodoOperationResult{Status: http.StatusOK, Todo: ref.Ref(toApiTodo(result.Todo))}
+		}
+	}
+	return BatchTodos200JSONResponse{Results: out}, nil
+}
@@ -0,0 +1,50 @@
+package api
+
+import (
+	"context"
+
+	"github.com/astromechza/todo-app/backend/model"
+)
+
+func toApiWorkflow(item *model.Workflow) Workflow {
+	out := Workflow{
+		InitialStatus: item.InitialStatus,
+		Statuses:      item.Statuses,
+		Transitions:   make([]WorkflowTransition, 0, len(item.Transitions)),
+	}
+	// follow the order of the statuses so that the output is stable
+	for _, status := range item.Statuses {
+		if targets, ok := item.Transitions[status]; ok {
+			out.Transitions = append(out.Transitions, WorkflowTransition{From: status, To: targets})
+		}
+	}
+	return out
+}
+
+func fromApiWorkflow(item *Workflow) model.Workflow {
+	out := model.Workflow{
+		InitialStatus: item.InitialStatus,
+		Statuses:      item.Statuses,
+		Transitions:   make(map[string][]string, len(item.Transitions)),
+	}
+	for _, transition := range item.Transitions {
+		out.Transitions[transition.From] = append(out.Transitions[transition.From], transition.To...)
+	}
+	return out
+}
+
+func (s *Server) GetWorkflow(ctx context.Context, request GetWorkflowRequestObject) (GetWorkflowResponseObject, error) {
+	res, err := s.Database.GetWorkflow(ctx, request.WorkspaceId)
+	if err != nil {
+		return nil, err
+	}
+	return GetWorkflow200JSONResponse(toApiWorkflow(res)), nil
+}
+
+func (s *Server) SetWorkflow(ctx context.Context, request SetWorkflowRequestObject) (SetWorkflowResponseObject, error) {
+	if res, err := s.Database.SetWorkflow(ctx, request.WorkspaceId, fromApiWorkflow(request.Body)); err != nil {
+		return nil, err
+	} else {
+		return SetWorkflow200JSONResponse(toApiWorkflow(res)), nil
+	}
+}
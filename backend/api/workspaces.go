@@ -0,0 +1,75 @@
+package api
+
+import (
+	"context"
+
+	"github.com/astromechza/todo-app/backend/model"
+)
+
+func toApiWorkspace(item *model.Workspace) Workspace {
+	return Workspace{
+		Metadata: WorkspaceMetadata{
+			Id:        item.Id,
+			Epoch:     int(item.Epoch),
+			CreatedAt: item.EpochAt,
+		},
+		DisplayName: item.DisplayName,
+	}
+}
+
+func (s *Server) GetWorkspace(ctx context.Context, request GetWorkspaceRequestObject) (GetWorkspaceResponseObject, error) {
+	res, err := s.Database.GetWorkspace(ctx, request.WorkspaceId)
+	if err != nil {
+		return nil, err
+	}
+	return GetWorkspace200JSONResponse(toApiWorkspace(res)), nil
+}
+
+func (s *Server) ListWorkspaces(ctx context.Context, request ListWorkspacesRequestObject) (ListWorkspacesResponseObject, error) {
+	// anonymous requests only see the public workspace
+	visibleTo := ""
+	if user, ok := userFrom(ctx); ok {
+		visibleTo = user.Id
+	}
+	res, err := s.Database.ListWorkspaces(ctx, model.ListWorkspacesParams{
+		VisibleTo: &visibleTo,
+		PageToken: request.Params.Page,
+		PageSize:  request.Params.PageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Workspace, len(res.Items))
+	for i, item := range res.Items {
+		out[i] = toApiWorkspace(&item)
+	}
+	return ListWorkspaces200JSONResponse(WorkspacePage{
+		Items:          out,
+		RemainingItems: res.RemainingItems,
+		NextPageToken:  res.NextPageToken,
+	}), nil
+}
+
+func (s *Server) CreateWorkspace(ctx context.Context, request CreateWorkspaceRequestObject) (CreateWorkspaceResponseObject, error) {
+	user, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params := model.CreateWorkspacesParams{
+		Id:          request.Body.Id,
+		DisplayName: request.Body.DisplayName,
+		MemberId:    &user.Id,
+	}
+	if res, err := s.Database.CreateWorkspace(ctx, params); err != nil {
+		return nil, err
+	} else {
+		return CreateWorkspace201JSONResponse(toApiWorkspace(res)), nil
+	}
+}
+
+func (s *Server) DeleteWorkspace(ctx context.Context, request DeleteWorkspaceRequestObject) (DeleteWorkspaceResponseObject, error) {
+	if err := s.Database.DeleteWorkspace(ctx, request.WorkspaceId); err != nil {
+		return nil, err
+	}
+	return DeleteWorkspace204Response{}, nil
+}
@@ -9,13 +9,18 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
 	"github.com/labstack/echo/v4"
 
 	"github.com/astromechza/todo-app/backend/api"
+	"github.com/astromechza/todo-app/backend/auth"
+	"github.com/astromechza/todo-app/backend/model"
+	"github.com/astromechza/todo-app/backend/model/memmodel"
 	"github.com/astromechza/todo-app/backend/model/sqlmodel"
+	"github.com/astromechza/todo-app/pkg/oidc"
 )
 
 func main() {
@@ -33,25 +38,88 @@ func mainInner() error {
 	connectCtx, connectCancel := context.WithTimeout(context.Background(), time.Second*10)
 	defer connectCancel()
 
-	db, err := sqlmodel.NewSqlModel(connectCtx, os.Getenv("DB_STRING"))
-	if err != nil {
+	var err error
+	pageTokenTTL := model.DefaultPageTokenTTL
+	if raw := os.Getenv("PAGE_TOKEN_TTL"); raw != "" {
+		if pageTokenTTL, err = time.ParseDuration(raw); err != nil {
+			return fmt.Errorf("invalid PAGE_TOKEN_TTL: %w", err)
+		}
+	}
+	pageTokenKey := []byte(os.Getenv("PAGE_TOKEN_KEY"))
+	if len(pageTokenKey) == 0 {
+		slog.Warn("PAGE_TOKEN_KEY is not set, page tokens will not be valid across restarts or replicas")
+		pageTokenKey = model.RandomPageTokenKey()
+	}
+	pageTokens := model.NewPageTokenSigner(pageTokenKey, pageTokenTTL)
+
+	var db model.Modelling
+	if dbString := os.Getenv("DB_STRING"); dbString == "memory://" {
+		slog.Warn("using in-memory database, all data will be lost on exit")
+		db = memmodel.NewMemModel(pageTokens)
+	} else if db, err = sqlmodel.NewSqlModel(connectCtx, dbString, pageTokens); err != nil {
 		return fmt.Errorf("failed to connect to database: %w", err)
 	}
 	defer db.Close(connectCtx)
 
-	apiServer := &api.Server{Database: db}
+	trashRetention := time.Hour * 24 * 30
+	if raw := os.Getenv("TRASH_RETENTION"); raw != "" {
+		if trashRetention, err = time.ParseDuration(raw); err != nil {
+			return fmt.Errorf("invalid TRASH_RETENTION: %w", err)
+		}
+	}
+	purgeCtx, purgeCancel := context.WithCancel(context.Background())
+	defer purgeCancel()
+	if trashRetention > 0 {
+		go purgeTrashLoop(purgeCtx, db, trashRetention)
+	} else {
+		slog.Warn("trash retention is disabled, deleted todos will be kept forever")
+	}
+
+	tokenTTL := auth.DefaultTokenTTL
+	if raw := os.Getenv("AUTH_TOKEN_TTL"); raw != "" {
+		if tokenTTL, err = time.ParseDuration(raw); err != nil {
+			return fmt.Errorf("invalid AUTH_TOKEN_TTL: %w", err)
+		}
+	}
+	tokenKey := []byte(os.Getenv("AUTH_TOKEN_KEY"))
+	if len(tokenKey) == 0 {
+		slog.Warn("AUTH_TOKEN_KEY is not set, bearer tokens will not be valid across restarts or replicas")
+		tokenKey = auth.RandomKey()
+	}
+	tokens := auth.NewSigner(tokenKey, tokenTTL)
+
+	events := model.NewEventBroker(model.DefaultEventBufferSize)
+	apiServer := &api.Server{Database: model.NewPublishingModel(db, events), Events: events, Tokens: tokens}
+	if issuer := os.Getenv("OIDC_ISSUER"); issuer != "" {
+		if apiServer.OidcClientId = os.Getenv("OIDC_CLIENT_ID"); apiServer.OidcClientId == "" {
+			return fmt.Errorf("OIDC_CLIENT_ID is required when OIDC_ISSUER is set")
+		}
+		apiServer.Oidc = oidc.NewProvider(issuer, nil)
+		slog.Info("accepting id tokens for sign in", "issuer", issuer, "client", apiServer.OidcClientId)
+	}
+	// anyone can register when there is no other way to sign in, but not once an identity provider is configured
+	apiServer.Registration = apiServer.Oidc == nil
+	if raw := os.Getenv("ALLOW_REGISTRATION"); raw != "" {
+		if apiServer.Registration, err = strconv.ParseBool(raw); err != nil {
+			return fmt.Errorf("invalid ALLOW_REGISTRATION: %w", err)
+		}
+	}
+	if apiServer.Registration {
+		slog.Info("anyone may register a user at POST /users, set ALLOW_REGISTRATION=false to disable it")
+	}
 
 	echoServer := echo.New()
 	echoServer.HidePort = true
 	echoServer.HideBanner = true
 	echoServer.HTTPErrorHandler = api.DefaultErrorHandler
 	echoServer.JSONSerializer = new(api.DefaultJsonSerializer)
+	echoServer.Use(api.BuildAuthMiddleware(db, tokens))
 	if middleware, err := api.BuildOpenApiValidator(ApiSpec); err != nil {
 		return err
 	} else {
 		echoServer.Use(middleware)
 	}
-	api.RegisterHandlers(echoServer, api.NewStrictHandler(apiServer, []api.StrictMiddlewareFunc{}))
+	api.RegisterHandlers(echoServer, api.NewStrictHandler(apiServer, []api.StrictMiddlewareFunc{api.BuildRoleMiddleware()}))
 
 	defer func() {
 		if err := echoServer.Close(); err != nil {
@@ -86,3 +154,24 @@ func mainInner() error {
 		return fmt.Errorf("failed to listen: %w", err)
 	}
 }
+
+// purgeTrashLoop permanently removes todos that have been in the trash for longer than the retention period. It runs
+// at least once an hour until the context is cancelled.
+func purgeTrashLoop(ctx context.Context, db model.Modelling, retention time.Duration) {
+	interval := min(retention, time.Hour)
+	slog.Info("purging trash periodically", "retention", retention, "interval", interval)
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		if purged, err := db.PurgeTrash(ctx, time.Now().Add(-retention)); err != nil {
+			slog.Error("failed to purge trash", "err", err)
+		} else if purged > 0 {
+			slog.Info("purged todos from trash", "count", purged)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
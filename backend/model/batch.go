@@ -0,0 +1,87 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MaxBatchOperations is the largest number of operations accepted in one call to BatchTodos. This is large enough for
+// imports, the batch endpoint of the api accepts far fewer operations per request.
+const MaxBatchOperations = 10000
+
+type TodoOperationKind string
+
+const (
+	TodoOperationCreate TodoOperationKind = "create"
+	TodoOperationUpdate TodoOperationKind = "update"
+	TodoOperationDelete TodoOperationKind = "delete"
+)
+
+// TodoOperation is one operation of a batch. The todo id is used by updates and deletes, and only the params that
+// match the kind of the operation are used.
+type TodoOperation struct {
+	Kind   TodoOperationKind
+	TodoId string
+	Create CreateTodosParams
+	Update UpdateTodosParams
+	Delete DeleteTodosParams
+}
+
+type BatchTodosParams struct {
+	Operations []TodoOperation
+	// Partial applies every operation that succeeds and reports the failures in the results, rather than applying
+	// none of the operations when any of them fails. Only the failures reported by IsOperationError are kept in the
+	// results, any other error still aborts the whole batch.
+	Partial bool
+}
+
+// IsOperationError reports whether the error is one of the model errors that a partial batch reports as the result of
+// the failed operation, rather than an error like a failure of the database after which the batch cannot continue.
+func IsOperationError(err error) bool {
+	return errors.As(err, new(ErrBadRequest)) || errors.As(err, new(ErrNotFound)) || errors.As(err, new(ErrConflict)) ||
+		errors.As(err, new(ErrPreconditionFailed)) || errors.As(err, new(ErrUnauthorized)) || errors.As(err, new(ErrForbidden))
+}
+
+// TodoOperationResult is the outcome of an operation in a batch. Todo is the created, updated, or trashed todo, and Err
+// is only set for a failed operation of a partial batch.
+type TodoOperationResult struct {
+	Todo *Todo
+	Err  error
+}
+
+// CheckBatch validates the number of operations in the batch.
+func CheckBatch(params BatchTodosParams) error {
+	if len(params.Operations) == 0 || len(params.Operations) > MaxBatchOperations {
+		return ErrBadRequest(fmt.Sprintf("a batch must have between 1 and %d operations", MaxBatchOperations))
+	}
+	return nil
+}
+
+// Check validates the kind of the operation and that it names a todo when it needs one.
+func (op *TodoOperation) Check() error {
+	switch op.Kind {
+	case TodoOperationCreate:
+	case TodoOperationUpdate, TodoOperationDelete:
+		if op.TodoId == "" {
+			return ErrBadRequest(fmt.Sprintf("a %s operation requires a todo id", op.Kind))
+		}
+	default:
+		return ErrBadRequest(fmt.Sprintf("unknown operation '%s'", op.Kind))
+	}
+	return nil
+}
+
+// BatchError is returned when an operation of a batch fails and none of the batch was applied. It wraps the error of
+// the operation so that it is reported in the same way.
+type BatchError struct {
+	Index int
+	Err   error
+}
+
+func (e *BatchError) Error() string {
+	return fmt.Sprintf("operation %d: %s", e.Index, e.Err.Error())
+}
+
+func (e *BatchError) Unwrap() error {
+	return e.Err
+}
@@ -11,3 +11,31 @@ type ErrBadRequest string
 func (e ErrBadRequest) Error() string {
 	return string(e)
 }
+
+type ErrConflict string
+
+func (e ErrConflict) Error() string {
+	return string(e)
+}
+
+// ErrPreconditionFailed is returned when a conditional request names an epoch or revision that does not match the
+// current state of the entity.
+type ErrPreconditionFailed string
+
+func (e ErrPreconditionFailed) Error() string {
+	return string(e)
+}
+
+// ErrUnauthorized is returned when a request requires a user but has no valid credentials.
+type ErrUnauthorized string
+
+func (e ErrUnauthorized) Error() string {
+	return string(e)
+}
+
+// ErrForbidden is returned when the user of a request is not allowed to access the resource.
+type ErrForbidden string
+
+func (e ErrForbidden) Error() string {
+	return string(e)
+}
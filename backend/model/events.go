@@ -0,0 +1,255 @@
+package model
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultEventBufferSize is the number of recent events kept by an EventBroker so that subscribers can resume after a
+// reconnect.
+const DefaultEventBufferSize = 1000
+
+// subscriptionBufferSize is the number of events queued for a subscriber before it is considered too slow and
+// disconnected. A disconnected subscriber may resume from its last event while that event is still buffered.
+const subscriptionBufferSize = 256
+
+// TodoEvent is published for every change to a todo. Type is one of the RevisionAction constants and Todo is the todo
+// after the change. A deleted todo is the todo as it was moved to the trash, or as it was when its group was deleted.
+type TodoEvent struct {
+	// Cursor identifies the position of the event in the stream, see EventBroker.Subscribe.
+	Cursor      string
+	WorkspaceId string
+	Type        string
+	Todo        Todo
+}
+
+// EventBroker is an in-process pub/sub of todo events. It keeps a ring of the most recent events so that subscribers
+// can resume from the cursor of the last event they received. Events are only seen by subscribers of the same process.
+type EventBroker struct {
+	lock sync.Mutex
+	// stream is a random id of this broker so that cursors issued by another process or before a restart are detected.
+	stream      string
+	sequence    uint64
+	buffer      []TodoEvent
+	subscribers map[*EventSubscription]bool
+}
+
+func NewEventBroker(bufferSize int) *EventBroker {
+	raw := make([]byte, 6)
+	if _, err := rand.Read(raw); err != nil {
+		panic(err)
+	}
+	return &EventBroker{
+		stream:      hex.EncodeToString(raw),
+		buffer:      make([]TodoEvent, 0, max(bufferSize, 1)),
+		subscribers: make(map[*EventSubscription]bool),
+	}
+}
+
+// Publish assigns the next cursor to the event and delivers it to the subscribers of its workspace. A subscriber that
+// is not keeping up is closed rather than blocking the publisher.
+func (b *EventBroker) Publish(workspaceId string, eventType string, todo Todo) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.sequence++
+	event := TodoEvent{Cursor: b.cursor(b.sequence), WorkspaceId: workspaceId, Type: eventType, Todo: todo}
+	if len(b.buffer) == cap(b.buffer) {
+		copy(b.buffer, b.buffer[1:])
+		b.buffer = b.buffer[:len(b.buffer)-1]
+	}
+	b.buffer = append(b.buffer, event)
+	for sub := range b.subscribers {
+		if sub.workspaceId != workspaceId {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			b.unsubscribe(sub)
+		}
+	}
+}
+
+// Subscribe starts a subscription to the events of the workspace. When a cursor is given, the buffered events after it
+// are delivered first. If the events after the cursor are no longer buffered, or the cursor was not issued by this
+// broker, the subscription is marked as Reset since some events have been missed.
+func (b *EventBroker) Subscribe(workspaceId string, cursor *string) (*EventSubscription, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	sub := &EventSubscription{broker: b, workspaceId: workspaceId, Cursor: b.cursor(b.sequence)}
+
+	var replay []TodoEvent
+	if cursor != nil {
+		stream, rawSequence, _ := strings.Cut(*cursor, "-")
+		sequence, err := strconv.ParseUint(rawSequence, 10, 64)
+		if err != nil {
+			return nil, ErrBadRequest(fmt.Sprintf("invalid event cursor '%s'", *cursor))
+		}
+		oldest := b.sequence + 1 - uint64(len(b.buffer))
+		if stream != b.stream || sequence > b.sequence || sequence+1 < oldest {
+			sub.Reset = true
+		} else {
+			for _, event := range b.buffer[sequence+1-oldest:] {
+				if event.WorkspaceId == workspaceId {
+					replay = append(replay, event)
+				}
+			}
+		}
+	}
+
+	sub.events = make(chan TodoEvent, subscriptionBufferSize+len(replay))
+	for _, event := range replay {
+		sub.events <- event
+	}
+	b.subscribers[sub] = true
+	return sub, nil
+}
+
+func (b *EventBroker) cursor(sequence uint64) string {
+	return b.stream + "-" + strconv.FormatUint(sequence, 10)
+}
+
+// CloseWorkspace closes every subscription to the workspace, for when the workspace is deleted.
+func (b *EventBroker) CloseWorkspace(workspaceId string) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	for sub := range b.subscribers {
+		if sub.workspaceId == workspaceId {
+			b.unsubscribe(sub)
+		}
+	}
+}
+
+// unsubscribe removes the subscriber and closes its channel. The caller must hold the lock.
+func (b *EventBroker) unsubscribe(sub *EventSubscription) {
+	if b.subscribers[sub] {
+		delete(b.subscribers, sub)
+		close(sub.events)
+	}
+}
+
+// EventSubscription receives the events of a workspace until it is closed.
+type EventSubscription struct {
+	broker      *EventBroker
+	workspaceId string
+	events      chan TodoEvent
+	// Cursor is the cursor of the latest event published before the subscription started.
+	Cursor string
+	// Reset is set when the events after the requested cursor could not be replayed, so the subscriber should reload
+	// the todos rather than rely on the events alone.
+	Reset bool
+}
+
+// Events returns the channel of events. The channel is closed when the subscription is closed or the subscriber falls
+// too far behind, in which case it can subscribe again from the cursor of the last event it received.
+func (s *EventSubscription) Events() <-chan TodoEvent {
+	return s.events
+}
+
+func (s *EventSubscription) Close() {
+	s.broker.lock.Lock()
+	defer s.broker.lock.Unlock()
+	s.broker.unsubscribe(s)
+}
+
+// publishingModel publishes an event to the broker after every successful change to a todo. The active todos of a
+// deleted group are published as deleted, and those that a removed member is cleared from as updated. No events are
+// published when the trash is purged, since the purged todos were published as deleted when they were moved to the
+// trash, and the subscriptions to a deleted workspace are closed.
+type publishingModel struct {
+	Modelling
+	broker *EventBroker
+}
+
+// NewPublishingModel wraps the model so that the changes made through it are published to the broker.
+func NewPublishingModel(inner Modelling, broker *EventBroker) Modelling {
+	return &publishingModel{Modelling: inner, broker: broker}
+}
+
+func (m *publishingModel) CreateTodo(ctx context.Context, workspaceId string, params CreateTodosParams) (*Todo, error) {
+	out, err := m.Modelling.CreateTodo(ctx, workspaceId, params)
+	if err == nil {
+		m.broker.Publish(workspaceId, RevisionActionCreated, *out)
+	}
+	return out, err
+}
+
+func (m *publishingModel) UpdateTodo(ctx context.Context, workspaceId string, id string, params UpdateTodosParams) (*Todo, error) {
+	out, err := m.Modelling.UpdateTodo(ctx, workspaceId, id, params)
+	if err == nil {
+		m.broker.Publish(workspaceId, RevisionActionUpdated, *out)
+	}
+	return out, err
+}
+
+func (m *publishingModel) DeleteTodo(ctx context.Context, workspaceId string, id string, params DeleteTodosParams) (*Todo, error) {
+	out, err := m.Modelling.DeleteTodo(ctx, workspaceId, id, params)
+	if err == nil {
+		m.broker.Publish(workspaceId, RevisionActionDeleted, *out)
+	}
+	return out, err
+}
+
+func (m *publishingModel) DeleteGroup(ctx context.Context, workspaceId string, id string) ([]Todo, error) {
+	out, err := m.Modelling.DeleteGroup(ctx, workspaceId, id)
+	if err == nil {
+		for _, todo := range out {
+			m.broker.Publish(workspaceId, RevisionActionDeleted, todo)
+		}
+	}
+	return out, err
+}
+
+func (m *publishingModel) DeleteWorkspace(ctx context.Context, id string) error {
+	err := m.Modelling.DeleteWorkspace(ctx, id)
+	if err == nil {
+		m.broker.CloseWorkspace(id)
+	}
+	return err
+}
+
+func (m *publishingModel) RemoveWorkspaceMember(ctx context.Context, workspaceId string, userId string) ([]Todo, error) {
+	out, err := m.Modelling.RemoveWorkspaceMember(ctx, workspaceId, userId)
+	if err == nil {
+		for _, todo := range out {
+			if todo.DeletedAt == nil {
+				m.broker.Publish(workspaceId, RevisionActionUpdated, todo)
+			}
+		}
+	}
+	return out, err
+}
+
+func (m *publishingModel) BatchTodos(ctx context.Context, workspaceId string, params BatchTodosParams) ([]TodoOperationResult, error) {
+	results, err := m.Modelling.BatchTodos(ctx, workspaceId, params)
+	if err != nil {
+		return nil, err
+	}
+	for i, result := range results {
+		if result.Err != nil {
+			continue
+		}
+		switch params.Operations[i].Kind {
+		case TodoOperationCreate:
+			m.broker.Publish(workspaceId, RevisionActionCreated, *result.Todo)
+		case TodoOperationUpdate:
+			m.broker.Publish(workspaceId, RevisionActionUpdated, *result.Todo)
+		case TodoOperationDelete:
+			m.broker.Publish(workspaceId, RevisionActionDeleted, *result.Todo)
+		}
+	}
+	return results, nil
+}
+
+func (m *publishingModel) RestoreTodo(ctx context.Context, workspaceId string, id string) (*Todo, error) {
+	out, err := m.Modelling.RestoreTodo(ctx, workspaceId, id)
+	if err == nil {
+		m.broker.Publish(workspaceId, RevisionActionRestored, *out)
+	}
+	return out, err
+}
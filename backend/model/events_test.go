@@ -0,0 +1,45 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/astromechza/todo-app/pkg/ref"
+)
+
+func TestEventBrokerResumeAndOverflow(t *testing.T) {
+	b := NewEventBroker(2)
+	first, err := b.Subscribe("ws", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.Publish("ws", RevisionActionCreated, Todo{Id: 1})
+	b.Publish("other", RevisionActionCreated, Todo{Id: 1})
+	b.Publish("ws", RevisionActionUpdated, Todo{Id: 1})
+	if event := <-first.Events(); event.Type != RevisionActionCreated {
+		t.Fatalf("unexpected event %+v", event)
+	}
+	second := <-first.Events()
+
+	// the first event has been evicted from the buffer of two events, so the stream cannot be resumed from before it
+	if sub, _ := b.Subscribe("ws", &second.Cursor); sub.Reset || len(sub.Events()) != 0 {
+		t.Errorf("expected to resume after the latest event")
+	}
+	if sub, _ := b.Subscribe("ws", ref.Ref(b.cursor(0))); !sub.Reset {
+		t.Errorf("expected a reset for an evicted cursor")
+	}
+	if _, err := b.Subscribe("ws", ref.Ref("garbage")); err == nil {
+		t.Errorf("expected an invalid cursor to fail")
+	}
+
+	for i := 0; i <= subscriptionBufferSize; i++ {
+		b.Publish("ws", RevisionActionUpdated, Todo{Id: 1})
+	}
+	count := 0
+	for range first.Events() {
+		count++
+	}
+	if count != subscriptionBufferSize {
+		t.Errorf("expected the slow subscriber to be closed after %d events, got %d", subscriptionBufferSize, count)
+	}
+	first.Close()
+}
@@ -0,0 +1,116 @@
+package model
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/astromechza/todo-app/pkg/ref"
+)
+
+// TodoFilter selects the todos returned by ListTodos. The filter of the first page is recorded in its page token so
+// that the following pages are listed with the same filter.
+type TodoFilter struct {
+	ByGroup  []string `json:"g,omitempty"`
+	ByStatus []string `json:"s,omitempty"`
+	// ByAssignee matches the todos assigned to the user id, or the unassigned todos when it is empty.
+	ByAssignee *string `json:"a,omitempty"`
+	// Trashed lists the deleted todos in the trash instead of the active todos.
+	Trashed bool `json:"t,omitempty"`
+	// Query searches the title and details of the todos, the results are ordered by relevance.
+	Query *string `json:"q,omitempty"`
+	// CreatedAfter and CreatedBefore match the time the todo was created, UpdatedAfter and UpdatedBefore match the
+	// time of its latest revision. The after bounds are inclusive and the before bounds are exclusive.
+	CreatedAfter  *time.Time `json:"ca,omitempty"`
+	CreatedBefore *time.Time `json:"cb,omitempty"`
+	UpdatedAfter  *time.Time `json:"ua,omitempty"`
+	UpdatedBefore *time.Time `json:"ub,omitempty"`
+}
+
+// Matches reports whether the todo passes the filter. The search query is not considered since it is scored
+// separately, see SearchScore.
+func (f *TodoFilter) Matches(todo *Todo) bool {
+	return (todo.DeletedAt != nil) == f.Trashed &&
+		(len(f.ByGroup) == 0 || slices.Contains(f.ByGroup, todo.Group.Id)) &&
+		(len(f.ByStatus) == 0 || slices.Contains(f.ByStatus, todo.Status)) &&
+		(f.ByAssignee == nil || *f.ByAssignee == ref.DeRefOr(todo.Assignee, "")) &&
+		inRange(todo.EpochAt, f.CreatedAfter, f.CreatedBefore) &&
+		inRange(todo.RevisionAt, f.UpdatedAfter, f.UpdatedBefore)
+}
+
+func inRange(t time.Time, after, before *time.Time) bool {
+	return (after == nil || !t.Before(*after)) && (before == nil || t.Before(*before))
+}
+
+// Resolve normalises and validates the filter and reconciles it with the filter recorded in the page token of the
+// previous page, if any. A request without a filter continues with the recorded one, while a request with a
+// different filter is rejected since the page token would not be valid for it.
+func (f *TodoFilter) Resolve(recorded *TodoFilter) error {
+	f.normalise()
+	for _, r := range []struct {
+		name          string
+		after, before *time.Time
+	}{{"created", f.CreatedAfter, f.CreatedBefore}, {"updated", f.UpdatedAfter, f.UpdatedBefore}} {
+		if r.after != nil && r.before != nil && !r.after.Before(*r.before) {
+			return ErrBadRequest("the " + r.name + " after time must be before the " + r.name + " before time")
+		}
+	}
+	if recorded == nil {
+		return nil
+	}
+	recorded.normalise()
+	if f.Trashed == recorded.Trashed && f.isZero() {
+		*f = *recorded
+		return nil
+	}
+	if !f.equal(recorded) {
+		return ErrBadRequest("the filter does not match the filter of the page token")
+	}
+	return nil
+}
+
+func (f *TodoFilter) normalise() {
+	f.ByGroup, f.ByStatus = normaliseValues(f.ByGroup), normaliseValues(f.ByStatus)
+	if f.Query != nil && strings.TrimSpace(*f.Query) == "" {
+		f.Query = nil
+	}
+	for _, t := range []**time.Time{&f.CreatedAfter, &f.CreatedBefore, &f.UpdatedAfter, &f.UpdatedBefore} {
+		if *t != nil {
+			utc := (*t).UTC()
+			*t = &utc
+		}
+	}
+}
+
+// normaliseValues sorts and de-duplicates a copy of the values so that the same filter always compares equal.
+func normaliseValues(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	out := slices.Clone(values)
+	slices.Sort(out)
+	return slices.Compact(out)
+}
+
+func (f *TodoFilter) isZero() bool {
+	return f.equal(&TodoFilter{Trashed: f.Trashed})
+}
+
+func (f *TodoFilter) equal(other *TodoFilter) bool {
+	return f.Trashed == other.Trashed &&
+		slices.Equal(f.ByGroup, other.ByGroup) &&
+		slices.Equal(f.ByStatus, other.ByStatus) &&
+		equalPtr(f.ByAssignee, other.ByAssignee, func(a, b string) bool { return a == b }) &&
+		equalPtr(f.Query, other.Query, func(a, b string) bool { return a == b }) &&
+		equalPtr(f.CreatedAfter, other.CreatedAfter, time.Time.Equal) &&
+		equalPtr(f.CreatedBefore, other.CreatedBefore, time.Time.Equal) &&
+		equalPtr(f.UpdatedAfter, other.UpdatedAfter, time.Time.Equal) &&
+		equalPtr(f.UpdatedBefore, other.UpdatedBefore, time.Time.Equal)
+}
+
+func equalPtr[T any](a, b *T, eq func(T, T) bool) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return eq(*a, *b)
+}
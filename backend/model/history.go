@@ -0,0 +1,86 @@
+package model
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+const (
+	RevisionActionCreated  = "created"
+	RevisionActionUpdated  = "updated"
+	RevisionActionDeleted  = "deleted"
+	RevisionActionRestored = "restored"
+)
+
+const (
+	ActorKindUser           = "user"
+	ActorKindServiceAccount = "service_account"
+)
+
+// Actor is the user or service account that made a revision.
+type Actor struct {
+	// Kind is one of the ActorKind constants.
+	Kind string
+	Id   string
+}
+
+type actorContextKey struct{}
+
+// ContextWithActor returns a context in which the revisions made to todos are recorded against the actor.
+func ContextWithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+// ActorFrom returns the actor set by ContextWithActor, or nil if the changes are being made anonymously.
+func ActorFrom(ctx context.Context) *Actor {
+	if actor, ok := ctx.Value(actorContextKey{}).(Actor); ok {
+		return &actor
+	}
+	return nil
+}
+
+// TodoRevision is an entry in the history of a todo, one is recorded for every revision of the todo.
+type TodoRevision struct {
+	Revision   int64
+	RevisionAt time.Time
+	// Action is one of the RevisionAction constants.
+	Action  string
+	Changes []TodoFieldChange
+	// Actor is the user or service account that made the revision, or nil if it was made anonymously.
+	Actor *Actor
+}
+
+// TodoFieldChange records the previous and new value of a field that changed in a revision. A nil value means the
+// field was not set.
+type TodoFieldChange struct {
+	Field string
+	From  *string
+	To    *string
+}
+
+// DiffTodo returns the changes to the user editable fields between two versions of a todo. A nil before returns the
+// initial values of the todo.
+func DiffTodo(before *Todo, after *Todo) []TodoFieldChange {
+	if before == nil {
+		before = &Todo{}
+	}
+	changes := make([]TodoFieldChange, 0)
+	diff := func(field string, from *string, to *string) {
+		if (from == nil) != (to == nil) || (from != nil && *from != *to) {
+			changes = append(changes, TodoFieldChange{Field: field, From: from, To: to})
+		}
+	}
+	nonEmpty := func(v string) *string {
+		if v == "" {
+			return nil
+		}
+		return &v
+	}
+	diff("title", nonEmpty(before.Title), nonEmpty(after.Title))
+	diff("details", before.Details, after.Details)
+	diff("status", nonEmpty(before.Status), nonEmpty(after.Status))
+	diff("assignee", before.Assignee, after.Assignee)
+	diff("watchers", nonEmpty(strings.Join(before.Watchers, ",")), nonEmpty(strings.Join(after.Watchers, ",")))
+	return changes
+}
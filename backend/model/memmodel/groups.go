@@ -0,0 +1,136 @@
+package memmodel
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/astromechza/todo-app/backend/model"
+)
+
+// snapshot returns a copy of the group with the current count of todos that are not in the trash.
+func (g *groupState) snapshot() model.Group {
+	out := g.group
+	for _, t := range g.todos {
+		if t.DeletedAt == nil {
+			out.TodoCount++
+		}
+	}
+	if out.DisplayName != nil {
+		displayName := *out.DisplayName
+		out.DisplayName = &displayName
+	}
+	return out
+}
+
+func (m *memModel) GetGroup(ctx context.Context, workspaceId string, id string) (*model.Group, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	if ws, ok := m.workspaces[workspaceId]; ok {
+		if g, ok := ws.groups[id]; ok {
+			out := g.snapshot()
+			return &out, nil
+		}
+	}
+	return nil, model.ErrNotFound(fmt.Sprintf("group '%s' not found", id))
+}
+
+func (m *memModel) ListGroups(ctx context.Context, workspaceId string) ([]model.Group, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	ws, err := m.workspace(workspaceId)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]model.Group, 0, len(ws.groups))
+	for _, g := range ws.groups {
+		out = append(out, g.snapshot())
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Id < out[j].Id
+	})
+	return out, nil
+}
+
+func (m *memModel) CreateGroup(ctx context.Context, workspaceId string, params model.CreateGroupsParams) (*model.Group, error) {
+	var lastSerial int64
+	if params.NextSerial != nil {
+		if *params.NextSerial < 1 {
+			return nil, model.ErrBadRequest("next serial must be at least 1")
+		}
+		lastSerial = *params.NextSerial - 1
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	ws, err := m.workspace(workspaceId)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := ws.groups[params.Id]; ok {
+		return nil, model.ErrConflict(fmt.Sprintf("group '%s' already exists", params.Id))
+	}
+	g := &groupState{
+		group: model.Group{
+			Id:      params.Id,
+			Epoch:   rand.Int63(),
+			EpochAt: time.Now().UTC(),
+			Workspace: model.EntityReference{
+				Id:    ws.workspace.Id,
+				Epoch: ws.workspace.Epoch,
+			},
+			DisplayName: params.DisplayName,
+			LastSerial:  lastSerial,
+		},
+		todos:     make(map[int64]*model.Todo),
+		revisions: make(map[int64][]model.TodoRevision),
+	}
+	ws.groups[params.Id] = g
+	out := g.snapshot()
+	return &out, nil
+}
+
+func (m *memModel) UpdateGroup(ctx context.Context, workspaceId string, id string, params model.UpdateGroupsParams) (*model.Group, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	ws, ok := m.workspaces[workspaceId]
+	if !ok || ws.groups[id] == nil {
+		return nil, model.ErrNotFound(fmt.Sprintf("group '%s' not found", id))
+	}
+	g := ws.groups[id]
+	if params.NextSerial != nil {
+		// serials may only move forward, otherwise we would hand out ids that have been used before
+		if *params.NextSerial <= g.group.LastSerial {
+			return nil, model.ErrBadRequest(fmt.Sprintf("next serial must be greater than the last used serial %d", g.group.LastSerial))
+		}
+		g.group.LastSerial = *params.NextSerial - 1
+	}
+	if params.DisplayName != nil {
+		displayName := *params.DisplayName
+		g.group.DisplayName = &displayName
+	}
+	out := g.snapshot()
+	return &out, nil
+}
+
+func (m *memModel) DeleteGroup(ctx context.Context, workspaceId string, id string) ([]model.Todo, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	ws, ok := m.workspaces[workspaceId]
+	if !ok || ws.groups[id] == nil {
+		return nil, model.ErrNotFound(fmt.Sprintf("group '%s' not found", id))
+	}
+	active := make([]model.Todo, 0)
+	for _, t := range ws.groups[id].todos {
+		if t.DeletedAt == nil {
+			active = append(active, *t)
+		}
+	}
+	sort.Slice(active, func(i, j int) bool {
+		return active[i].Id < active[j].Id
+	})
+	delete(ws.groups, id)
+	return active, nil
+}
@@ -0,0 +1,509 @@
+package memmodel
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math/rand"
+	"slices"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/astromechza/todo-app/backend/model"
+	"github.com/astromechza/todo-app/pkg/ref"
+)
+
+// NewMemModel returns a model.Modelling that holds all of its state in memory. It is intended for tests and local
+// development where no database is available. The page tokens of its lists are signed with pageTokens.
+func NewMemModel(pageTokens *model.PageTokenSigner) model.Modelling {
+	return &memModel{
+		pageTokens: pageTokens,
+		workspaces: map[string]*workspaceState{
+			model.SharedWorkspaceId: {
+				workspace: model.Workspace{
+					Id:          model.SharedWorkspaceId,
+					Epoch:       model.DefaultWorkspaceEpoch,
+					EpochAt:     time.Now().UTC(),
+					DisplayName: "Public",
+				},
+				groups:          make(map[string]*groupState),
+				members:         make(map[string]model.WorkspaceMember),
+				serviceAccounts: make(map[string]model.ServiceAccount),
+			},
+		},
+		users:         make(map[string]model.User),
+		identities:    make(map[model.UserIdentity]string),
+		apiKeys:       make(map[string]model.ApiKey),
+		serviceTokens: make(map[string]model.ServiceAccountToken),
+	}
+}
+
+type memModel struct {
+	lock       sync.RWMutex
+	pageTokens *model.PageTokenSigner
+	workspaces map[string]*workspaceState
+	users      map[string]model.User
+	// identities maps each linked OpenID Connect identity to a user id
+	identities map[model.UserIdentity]string
+	// apiKeys is keyed by the hash of their secret
+	apiKeys map[string]model.ApiKey
+	// serviceTokens is keyed by the hash of their secret
+	serviceTokens map[string]model.ServiceAccountToken
+}
+
+type workspaceState struct {
+	workspace model.Workspace
+	// workflow is nil when the workspace uses the default workflow
+	workflow *model.Workflow
+	groups   map[string]*groupState
+	// members is keyed by user id
+	members map[string]model.WorkspaceMember
+	// serviceAccounts is keyed by service account id
+	serviceAccounts map[string]model.ServiceAccount
+}
+
+type groupState struct {
+	group model.Group
+	todos map[int64]*model.Todo
+	// revisions is the history of each todo in the group
+	revisions map[int64][]model.TodoRevision
+}
+
+// clone returns a copy of the workspace state that shares nothing mutable with the original.
+func (ws *workspaceState) clone() *workspaceState {
+	out := &workspaceState{workspace: ws.workspace, workflow: ws.workflow, groups: make(map[string]*groupState, len(ws.groups)), members: maps.Clone(ws.members), serviceAccounts: maps.Clone(ws.serviceAccounts)}
+	for id, g := range ws.groups {
+		cg := &groupState{group: g.group, todos: make(map[int64]*model.Todo, len(g.todos)), revisions: make(map[int64][]model.TodoRevision, len(g.revisions))}
+		for todoId, t := range g.todos {
+			ct := *t
+			cg.todos[todoId] = &ct
+		}
+		for todoId, revisions := range g.revisions {
+			cg.revisions[todoId] = slices.Clone(revisions)
+		}
+		out.groups[id] = cg
+	}
+	return out
+}
+
+// record appends a revision made by the actor in the context to the history of the todo. The caller must hold the lock.
+func (g *groupState) record(ctx context.Context, t *model.Todo, action string, changes []model.TodoFieldChange) {
+	if changes == nil {
+		changes = make([]model.TodoFieldChange, 0)
+	}
+	g.revisions[t.Id] = append(g.revisions[t.Id], model.TodoRevision{
+		Revision:   t.Revision,
+		RevisionAt: t.RevisionAt,
+		Action:     action,
+		Changes:    changes,
+		Actor:      model.ActorFrom(ctx),
+	})
+}
+
+func (m *memModel) HealthZ(ctx context.Context) error {
+	return nil
+}
+
+func (m *memModel) Close(ctx context.Context) error {
+	return nil
+}
+
+// workspace returns the state of the workspace or an ErrNotFound. The caller must hold the lock.
+func (m *memModel) workspace(id string) (*workspaceState, error) {
+	ws, ok := m.workspaces[id]
+	if !ok {
+		return nil, model.ErrNotFound(fmt.Sprintf("workspace '%s' not found", id))
+	}
+	return ws, nil
+}
+
+// checkPeople returns an ErrBadRequest unless every user is a member of the workspace, or any existing user in the
+// public workspace which has no members. The caller must hold the lock.
+func (m *memModel) checkPeople(ws *workspaceState, userIds []string) error {
+	for _, id := range userIds {
+		_, isUser := m.users[id]
+		_, isMember := ws.members[id]
+		if !isMember && (ws.workspace.Id != model.SharedWorkspaceId || !isUser) {
+			return model.ErrBadRequest(fmt.Sprintf("user '%s' is not a member of the workspace", id))
+		}
+	}
+	return nil
+}
+
+// todo returns the stored todo, either active or in the trash, or an ErrNotFound. The caller must hold the lock.
+func (m *memModel) todo(workspaceId string, id string, trashed bool) (*model.Todo, error) {
+	groupId, rawTodoId := model.SplitGroupId(id)
+	if todoId, err := strconv.ParseInt(rawTodoId, 10, 64); err == nil {
+		if ws, ok := m.workspaces[workspaceId]; ok {
+			if g, ok := ws.groups[groupId]; ok {
+				if t, ok := g.todos[todoId]; ok && (t.DeletedAt != nil) == trashed {
+					return t, nil
+				}
+			}
+		}
+	}
+	if trashed {
+		return nil, model.ErrNotFound("todo not found in trash")
+	}
+	return nil, model.ErrNotFound("todo not found")
+}
+
+func (m *memModel) GetTodo(ctx context.Context, workspaceId string, id string) (*model.Todo, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	t, err := m.todo(workspaceId, id, false)
+	if err != nil {
+		return nil, err
+	}
+	out := *t
+	return &out, nil
+}
+
+func (m *memModel) ListTodos(ctx context.Context, workspaceId string, params model.ListTodosParams) (*model.ListTodosPage, error) {
+	var pageToken struct {
+		LastGroupId string            `json:"g"`
+		LastId      int64             `json:"i"`
+		LastRank    float64           `json:"r,omitempty"`
+		LastKey     string            `json:"k,omitempty"`
+		Filter      *model.TodoFilter `json:"f,omitempty"`
+		Sort        *model.TodoSort   `json:"o,omitempty"`
+	}
+	if err := m.pageTokens.Decode(params.PageToken, model.TodosPageScope(workspaceId), &pageToken); err != nil {
+		return nil, err
+	}
+	if err := params.TodoFilter.Resolve(pageToken.Filter); err != nil {
+		return nil, err
+	}
+	if err := params.Sort.Resolve(pageToken.Sort); err != nil {
+		return nil, err
+	}
+	pageToken.Filter, pageToken.Sort = &params.TodoFilter, &params.Sort
+	limit, err := model.PageLimit(params.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	terms := model.SearchTerms(params.Query)
+
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	ws, err := m.workspace(workspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	type ranked struct {
+		todo model.Todo
+		rank float64
+		key  string
+	}
+	// before reports whether a sorts before b: by the sort key if there is one or by descending rank when searching,
+	// then by group and id
+	before := func(a, b ranked) bool {
+		if params.Sort.Field != model.TodoSortDefault {
+			if a.key != b.key {
+				return (a.key < b.key) != params.Sort.Descending
+			}
+		} else if a.rank != b.rank {
+			return a.rank > b.rank
+		}
+		if a.todo.Group.Id != b.todo.Group.Id {
+			return a.todo.Group.Id < b.todo.Group.Id
+		}
+		return a.todo.Id < b.todo.Id
+	}
+	last := ranked{
+		todo: model.Todo{Id: pageToken.LastId, Group: model.EntityReference{Id: pageToken.LastGroupId}},
+		rank: pageToken.LastRank,
+		key:  pageToken.LastKey,
+	}
+
+	matching := make([]ranked, 0)
+	for groupId, g := range ws.groups {
+		if len(params.ByGroup) > 0 && !slices.Contains(params.ByGroup, groupId) {
+			continue
+		}
+		for _, t := range g.todos {
+			if !params.Matches(t) {
+				continue
+			}
+			item := ranked{todo: *t, key: params.Sort.Key(t)}
+			if len(terms) > 0 {
+				if item.rank = model.SearchScore(t, terms); item.rank == 0 {
+					continue
+				}
+			}
+			if params.PageToken != nil && !before(last, item) {
+				continue
+			}
+			matching = append(matching, item)
+		}
+	}
+	sort.Slice(matching, func(i, j int) bool {
+		return before(matching[i], matching[j])
+	})
+
+	page := &model.ListTodosPage{Items: make([]model.Todo, 0, min(limit, len(matching)))}
+	for _, item := range matching[:min(limit, len(matching))] {
+		page.Items = append(page.Items, item.todo)
+	}
+	if len(matching) > limit {
+		page.RemainingItems = len(matching) - limit
+		last := matching[limit-1]
+		pageToken.LastGroupId, pageToken.LastId, pageToken.LastRank, pageToken.LastKey = last.todo.Group.Id, last.todo.Id, last.rank, last.key
+		if page.NextPageToken, err = m.pageTokens.Encode(model.TodosPageScope(workspaceId), pageToken); err != nil {
+			return nil, err
+		}
+	}
+	return page, nil
+}
+
+func (m *memModel) CreateTodo(ctx context.Context, workspaceId string, params model.CreateTodosParams) (*model.Todo, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.createTodo(ctx, workspaceId, params)
+}
+
+// createTodo creates the todo. The caller must hold the lock.
+func (m *memModel) createTodo(ctx context.Context, workspaceId string, params model.CreateTodosParams) (*model.Todo, error) {
+	ws, err := m.workspace(workspaceId)
+	if err != nil {
+		return nil, err
+	}
+	status, err := ws.currentWorkflow().InitialStatusOr(params.Status)
+	if err != nil {
+		return nil, err
+	}
+	watchers, err := model.NormaliseWatchers(params.Watchers)
+	if err != nil {
+		return nil, err
+	}
+	if params.Assignee != nil && *params.Assignee == "" {
+		params.Assignee = nil
+	}
+	if params.Details != nil && *params.Details == "" {
+		params.Details = nil
+	}
+	if err := m.checkPeople(ws, model.TodoPeople(params.Assignee, watchers)); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	g, ok := ws.groups[params.GroupId]
+	if !ok {
+		g = &groupState{
+			group: model.Group{
+				Id:      params.GroupId,
+				Epoch:   rand.Int63(),
+				EpochAt: now,
+				Workspace: model.EntityReference{
+					Id:    ws.workspace.Id,
+					Epoch: ws.workspace.Epoch,
+				},
+			},
+			todos:     make(map[int64]*model.Todo),
+			revisions: make(map[int64][]model.TodoRevision),
+		}
+		ws.groups[params.GroupId] = g
+	}
+	id := g.group.LastSerial + 1
+	if _, taken := g.todos[ref.DeRefOr(params.Id, 0)]; params.Id != nil && *params.Id > 0 && !taken {
+		id = *params.Id
+	}
+	g.group.LastSerial = max(g.group.LastSerial, id)
+
+	out := model.Todo{
+		Id:         id,
+		Epoch:      rand.Int63(),
+		EpochAt:    now,
+		Revision:   0,
+		RevisionAt: now,
+		Workspace: model.EntityReference{
+			Id:    ws.workspace.Id,
+			Epoch: ws.workspace.Epoch,
+		},
+		Group: model.EntityReference{
+			Id:    g.group.Id,
+			Epoch: g.group.Epoch,
+		},
+		Title:    params.Title,
+		Status:   status,
+		Details:  params.Details,
+		Assignee: params.Assignee,
+		Watchers: watchers,
+	}
+	stored := out
+	g.todos[out.Id] = &stored
+	g.record(ctx, &stored, model.RevisionActionCreated, model.DiffTodo(nil, &stored))
+	return &out, nil
+}
+
+func (m *memModel) UpdateTodo(ctx context.Context, workspaceId string, id string, params model.UpdateTodosParams) (*model.Todo, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.updateTodo(ctx, workspaceId, id, params)
+}
+
+// updateTodo updates the todo. The caller must hold the lock.
+func (m *memModel) updateTodo(ctx context.Context, workspaceId string, id string, params model.UpdateTodosParams) (*model.Todo, error) {
+	t, err := m.todo(workspaceId, id, false)
+	if err != nil {
+		return nil, err
+	}
+	if params.Epoch != nil && (*params.Epoch != t.Epoch || params.Revision != t.Revision) {
+		return nil, model.ErrPreconditionFailed("todo does not match the requested epoch and revision")
+	} else if t.Revision != params.Revision {
+		return nil, model.ErrConflict(fmt.Sprintf("revision %d does not match current revision %d", params.Revision, t.Revision))
+	}
+	if params.Status != nil {
+		if err := m.workspaces[workspaceId].currentWorkflow().CheckTransition(t.Status, *params.Status); err != nil {
+			return nil, err
+		}
+	}
+	var watchers []string
+	if params.Watchers != nil {
+		if watchers, err = model.NormaliseWatchers(*params.Watchers); err != nil {
+			return nil, err
+		}
+	}
+	if err := m.checkPeople(m.workspaces[workspaceId], model.TodoPeople(params.Assignee, watchers)); err != nil {
+		return nil, err
+	}
+	before := *t
+	if params.Status != nil {
+		t.Status = *params.Status
+	}
+	if params.Title != nil {
+		t.Title = *params.Title
+	}
+	if params.Details != nil {
+		t.Details = nil
+		if *params.Details != "" {
+			details := *params.Details
+			t.Details = &details
+		}
+	}
+	if params.Assignee != nil {
+		t.Assignee = nil
+		if *params.Assignee != "" {
+			assignee := *params.Assignee
+			t.Assignee = &assignee
+		}
+	}
+	if params.Watchers != nil {
+		t.Watchers = watchers
+	}
+	t.Revision++
+	t.RevisionAt = time.Now().UTC()
+	m.workspaces[workspaceId].groups[t.Group.Id].record(ctx, t, model.RevisionActionUpdated, model.DiffTodo(&before, t))
+	out := *t
+	return &out, nil
+}
+
+func (m *memModel) DeleteTodo(ctx context.Context, workspaceId string, id string, params model.DeleteTodosParams) (*model.Todo, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.deleteTodo(ctx, workspaceId, id, params)
+}
+
+// deleteTodo moves the todo to the trash and returns the trashed todo. The caller must hold the lock.
+func (m *memModel) deleteTodo(ctx context.Context, workspaceId string, id string, params model.DeleteTodosParams) (*model.Todo, error) {
+	t, err := m.todo(workspaceId, id, false)
+	if err != nil {
+		return nil, err
+	}
+	if params.Epoch != nil && *params.Epoch != t.Epoch {
+		return nil, model.ErrPreconditionFailed("todo does not match the requested epoch")
+	}
+	if params.Revision != nil && *params.Revision != t.Revision {
+		return nil, model.ErrPreconditionFailed("todo does not match the requested revision")
+	}
+	now := time.Now().UTC()
+	t.DeletedAt = &now
+	t.Revision++
+	t.RevisionAt = now
+	m.workspaces[workspaceId].groups[t.Group.Id].record(ctx, t, model.RevisionActionDeleted, nil)
+	out := *t
+	return &out, nil
+}
+
+func (m *memModel) BatchTodos(ctx context.Context, workspaceId string, params model.BatchTodosParams) ([]model.TodoOperationResult, error) {
+	if err := model.CheckBatch(params); err != nil {
+		return nil, err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	ws, err := m.workspace(workspaceId)
+	if err != nil {
+		return nil, err
+	}
+	// the batch is undone by restoring a copy of the workspace taken before it started
+	backup := ws.clone()
+
+	results := make([]model.TodoOperationResult, len(params.Operations))
+	for i, op := range params.Operations {
+		if err := op.Check(); err != nil {
+			results[i].Err = err
+		} else {
+			switch op.Kind {
+			case model.TodoOperationCreate:
+				results[i].Todo, results[i].Err = m.createTodo(ctx, workspaceId, op.Create)
+			case model.TodoOperationUpdate:
+				results[i].Todo, results[i].Err = m.updateTodo(ctx, workspaceId, op.TodoId, op.Update)
+			case model.TodoOperationDelete:
+				results[i].Todo, results[i].Err = m.deleteTodo(ctx, workspaceId, op.TodoId, op.Delete)
+			}
+		}
+		if results[i].Err != nil && (!params.Partial || !model.IsOperationError(results[i].Err)) {
+			m.workspaces[workspaceId] = backup
+			return nil, &model.BatchError{Index: i, Err: results[i].Err}
+		}
+	}
+	return results, nil
+}
+
+func (m *memModel) RestoreTodo(ctx context.Context, workspaceId string, id string) (*model.Todo, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	t, err := m.todo(workspaceId, id, true)
+	if err != nil {
+		return nil, err
+	}
+	t.DeletedAt = nil
+	t.Revision++
+	t.RevisionAt = time.Now().UTC()
+	m.workspaces[workspaceId].groups[t.Group.Id].record(ctx, t, model.RevisionActionRestored, nil)
+	out := *t
+	return &out, nil
+}
+
+func (m *memModel) PurgeTrash(ctx context.Context, before time.Time) (int, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	var purged int
+	for _, ws := range m.workspaces {
+		for _, g := range ws.groups {
+			for todoId, t := range g.todos {
+				if t.DeletedAt != nil && t.DeletedAt.Before(before) {
+					delete(g.todos, todoId)
+					delete(g.revisions, todoId)
+					purged++
+				}
+			}
+		}
+	}
+	return purged, nil
+}
+
+func (m *memModel) ListTodoHistory(ctx context.Context, workspaceId string, id string) ([]model.TodoRevision, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	t, err := m.todo(workspaceId, id, false)
+	if err != nil {
+		if t, err = m.todo(workspaceId, id, true); err != nil {
+			return nil, model.ErrNotFound("todo not found")
+		}
+	}
+	return slices.Clone(m.workspaces[workspaceId].groups[t.Group.Id].revisions[t.Id]), nil
+}
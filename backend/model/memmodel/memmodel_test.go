@@ -0,0 +1,16 @@
+package memmodel
+
+import (
+	"testing"
+
+	"github.com/astromechza/todo-app/backend/model"
+	"github.com/astromechza/todo-app/backend/model/modeltest"
+)
+
+var _ model.Modelling = (*memModel)(nil)
+
+func TestConformance(t *testing.T) {
+	modeltest.Run(t, func(t *testing.T) model.Modelling {
+		return NewMemModel(model.NewPageTokenSigner(model.RandomPageTokenKey(), model.DefaultPageTokenTTL))
+	})
+}
@@ -0,0 +1,142 @@
+package memmodel
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sort"
+	"time"
+
+	"github.com/astromechza/todo-app/backend/model"
+)
+
+func (m *memModel) CreateServiceAccount(ctx context.Context, workspaceId string, params model.CreateServiceAccountsParams) (*model.ServiceAccount, error) {
+	if workspaceId == model.SharedWorkspaceId {
+		return nil, model.ErrBadRequest("the public workspace does not have service accounts")
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	ws, err := m.workspace(workspaceId)
+	if err != nil {
+		return nil, err
+	}
+	out := model.ServiceAccount{
+		Id:          model.NewWorkspaceId(),
+		WorkspaceId: workspaceId,
+		DisplayName: params.DisplayName,
+		CreatedAt:   time.Now().UTC(),
+	}
+	ws.serviceAccounts[out.Id] = out
+	return &out, nil
+}
+
+// serviceAccount returns the service account or an ErrNotFound. The caller must hold the lock.
+func (m *memModel) serviceAccount(workspaceId string, id string) (*model.ServiceAccount, error) {
+	ws, err := m.workspace(workspaceId)
+	if err != nil {
+		return nil, err
+	}
+	account, ok := ws.serviceAccounts[id]
+	if !ok {
+		return nil, model.ErrNotFound(fmt.Sprintf("service account '%s' not found", id))
+	}
+	return &account, nil
+}
+
+func (m *memModel) ListServiceAccounts(ctx context.Context, workspaceId string) ([]model.ServiceAccount, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	ws, err := m.workspace(workspaceId)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]model.ServiceAccount, 0, len(ws.serviceAccounts))
+	for _, account := range ws.serviceAccounts {
+		out = append(out, account)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Id < out[j].Id
+	})
+	return out, nil
+}
+
+func (m *memModel) DeleteServiceAccount(ctx context.Context, workspaceId string, id string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, err := m.serviceAccount(workspaceId, id); err != nil {
+		return err
+	}
+	delete(m.workspaces[workspaceId].serviceAccounts, id)
+	maps.DeleteFunc(m.serviceTokens, func(_ string, token model.ServiceAccountToken) bool {
+		return token.ServiceAccountId == id
+	})
+	return nil
+}
+
+func (m *memModel) CreateServiceAccountToken(ctx context.Context, workspaceId string, accountId string, params model.CreateServiceAccountTokensParams) (*model.ServiceAccountToken, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	account, err := m.serviceAccount(workspaceId, accountId)
+	if err != nil {
+		return nil, err
+	}
+	out := model.NewServiceAccountToken(account, params)
+	stored := out
+	stored.Secret = ""
+	m.serviceTokens[model.HashApiKey(out.Secret)] = stored
+	return &out, nil
+}
+
+func (m *memModel) ListServiceAccountTokens(ctx context.Context, workspaceId string, accountId string) ([]model.ServiceAccountToken, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	if _, err := m.serviceAccount(workspaceId, accountId); err != nil {
+		return nil, err
+	}
+	out := make([]model.ServiceAccountToken, 0)
+	for _, token := range m.serviceTokens {
+		if token.ServiceAccountId == accountId {
+			out = append(out, token)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Id < out[j].Id
+	})
+	return out, nil
+}
+
+func (m *memModel) RevokeServiceAccountToken(ctx context.Context, workspaceId string, accountId string, id string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, err := m.serviceAccount(workspaceId, accountId); err != nil {
+		return err
+	}
+	for hash, token := range m.serviceTokens {
+		if token.ServiceAccountId == accountId && token.Id == id {
+			if token.RevokedAt == nil {
+				now := time.Now().UTC()
+				token.RevokedAt = &now
+				m.serviceTokens[hash] = token
+			}
+			return nil
+		}
+	}
+	return model.ErrNotFound(fmt.Sprintf("service account token '%s' not found", id))
+}
+
+func (m *memModel) UseServiceAccountToken(ctx context.Context, secret string, at time.Time) (*model.ServiceAccountToken, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	hash := model.HashApiKey(secret)
+	token, ok := m.serviceTokens[hash]
+	if !ok || !token.Active(at) {
+		return nil, model.ErrNotFound("service account token not found")
+	}
+	at = at.UTC()
+	token.LastUsedAt = &at
+	m.serviceTokens[hash] = token
+	return &token, nil
+}
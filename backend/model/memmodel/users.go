@@ -0,0 +1,222 @@
+package memmodel
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sort"
+	"time"
+
+	"github.com/astromechza/todo-app/backend/model"
+	"github.com/astromechza/todo-app/pkg/ref"
+)
+
+func (m *memModel) CreateUser(ctx context.Context, params model.CreateUsersParams) (*model.User, error) {
+	out := model.User{
+		Id:          ref.DeRefOr(params.Id, model.NewUserId()),
+		EpochAt:     time.Now().UTC(),
+		DisplayName: params.DisplayName,
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.users[out.Id]; ok {
+		return nil, model.ErrConflict(fmt.Sprintf("user '%s' already exists", out.Id))
+	}
+	if params.Identity != nil {
+		if _, ok := m.identities[*params.Identity]; ok {
+			return nil, model.ErrConflict("the identity is already linked to a user")
+		}
+		m.identities[*params.Identity] = out.Id
+	}
+	m.users[out.Id] = out
+	return &out, nil
+}
+
+// user returns the user or an ErrNotFound. The caller must hold the lock.
+func (m *memModel) user(id string) (*model.User, error) {
+	u, ok := m.users[id]
+	if !ok {
+		return nil, model.ErrNotFound(fmt.Sprintf("user '%s' not found", id))
+	}
+	return &u, nil
+}
+
+func (m *memModel) GetUser(ctx context.Context, id string) (*model.User, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.user(id)
+}
+
+func (m *memModel) GetUserByApiKey(ctx context.Context, secret string) (*model.User, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	key, ok := m.apiKeys[model.HashApiKey(secret)]
+	if !ok {
+		return nil, model.ErrNotFound("api key not found")
+	}
+	return m.user(key.UserId)
+}
+
+func (m *memModel) GetUserByIdentity(ctx context.Context, identity model.UserIdentity) (*model.User, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	userId, ok := m.identities[identity]
+	if !ok {
+		return nil, model.ErrNotFound("no user is linked to the identity")
+	}
+	return m.user(userId)
+}
+
+func (m *memModel) CreateApiKey(ctx context.Context, userId string, params model.CreateApiKeysParams) (*model.ApiKey, error) {
+	out := model.NewApiKey(userId, params)
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, err := m.user(userId); err != nil {
+		return nil, err
+	}
+	stored := out
+	stored.Secret = ""
+	m.apiKeys[model.HashApiKey(out.Secret)] = stored
+	return &out, nil
+}
+
+func (m *memModel) ListApiKeys(ctx context.Context, userId string) ([]model.ApiKey, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	if _, err := m.user(userId); err != nil {
+		return nil, err
+	}
+	out := make([]model.ApiKey, 0)
+	for _, key := range m.apiKeys {
+		if key.UserId == userId {
+			out = append(out, key)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Id < out[j].Id
+	})
+	return out, nil
+}
+
+func (m *memModel) DeleteApiKey(ctx context.Context, userId string, id string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for hash, key := range m.apiKeys {
+		if key.UserId == userId && key.Id == id {
+			delete(m.apiKeys, hash)
+			return nil
+		}
+	}
+	return model.ErrNotFound(fmt.Sprintf("api key '%s' not found", id))
+}
+
+func (m *memModel) GetWorkspaceMember(ctx context.Context, workspaceId string, userId string) (*model.WorkspaceMember, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	ws, err := m.workspace(workspaceId)
+	if err != nil {
+		return nil, err
+	}
+	member, ok := ws.members[userId]
+	if !ok {
+		return nil, model.ErrNotFound(fmt.Sprintf("user '%s' is not a member of the workspace", userId))
+	}
+	member.GroupRoles = maps.Clone(member.GroupRoles)
+	return &member, nil
+}
+
+func (m *memModel) ListWorkspaceMembers(ctx context.Context, workspaceId string) ([]model.WorkspaceMember, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	ws, err := m.workspace(workspaceId)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]model.WorkspaceMember, 0, len(ws.members))
+	for _, member := range ws.members {
+		member.GroupRoles = maps.Clone(member.GroupRoles)
+		out = append(out, member)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].UserId < out[j].UserId
+	})
+	return out, nil
+}
+
+// isLastOwner reports whether the user is the only owner of the workspace, since nobody would be left to manage it
+// without them.
+func (ws *workspaceState) isLastOwner(userId string) bool {
+	if ws.members[userId].Role != model.RoleOwner {
+		return false
+	}
+	for id, member := range ws.members {
+		if id != userId && member.Role == model.RoleOwner {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *memModel) SetWorkspaceMember(ctx context.Context, workspaceId string, userId string, params model.SetWorkspaceMemberParams) (*model.WorkspaceMember, error) {
+	if workspaceId == model.SharedWorkspaceId {
+		return nil, model.ErrBadRequest("the public workspace does not have members")
+	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	ws, err := m.workspace(workspaceId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := m.user(userId); err != nil {
+		return nil, err
+	}
+	if params.Role != model.RoleOwner && ws.isLastOwner(userId) {
+		return nil, model.ErrBadRequest("the last owner of a workspace cannot be removed or given another role")
+	}
+	member, ok := ws.members[userId]
+	if !ok {
+		member = model.WorkspaceMember{WorkspaceId: workspaceId, UserId: userId, AddedAt: time.Now().UTC()}
+	}
+	member.Role, member.GroupRoles = params.Role, maps.Clone(params.GroupRoles)
+	ws.members[userId] = member
+	member.GroupRoles = maps.Clone(member.GroupRoles)
+	return &member, nil
+}
+
+func (m *memModel) RemoveWorkspaceMember(ctx context.Context, workspaceId string, userId string) ([]model.Todo, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	ws, err := m.workspace(workspaceId)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := ws.members[userId]; !ok {
+		return nil, model.ErrNotFound(fmt.Sprintf("user '%s' is not a member of the workspace", userId))
+	} else if ws.isLastOwner(userId) {
+		return nil, model.ErrBadRequest("the last owner of a workspace cannot be removed or given another role")
+	}
+	delete(ws.members, userId)
+	changed := make([]model.Todo, 0)
+	for _, g := range ws.groups {
+		for _, t := range g.todos {
+			before := *t
+			if !model.RemovePerson(t, userId) {
+				continue
+			}
+			t.Revision++
+			t.RevisionAt = time.Now().UTC()
+			g.record(ctx, t, model.RevisionActionUpdated, model.DiffTodo(&before, t))
+			changed = append(changed, *t)
+		}
+	}
+	sort.Slice(changed, func(i, j int) bool {
+		if changed[i].Group.Id != changed[j].Group.Id {
+			return changed[i].Group.Id < changed[j].Group.Id
+		}
+		return changed[i].Id < changed[j].Id
+	})
+	return changed, nil
+}
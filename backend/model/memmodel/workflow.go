@@ -0,0 +1,61 @@
+package memmodel
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/astromechza/todo-app/backend/model"
+)
+
+// currentWorkflow returns the workflow of the workspace. The caller must hold the lock.
+func (ws *workspaceState) currentWorkflow() *model.Workflow {
+	if ws.workflow == nil {
+		return &model.DefaultWorkflow
+	}
+	return ws.workflow
+}
+
+func copyWorkflow(w *model.Workflow) *model.Workflow {
+	out := model.Workflow{
+		InitialStatus: w.InitialStatus,
+		Statuses:      slices.Clone(w.Statuses),
+		Transitions:   make(map[string][]string, len(w.Transitions)),
+	}
+	for from, targets := range w.Transitions {
+		out.Transitions[from] = slices.Clone(targets)
+	}
+	return &out
+}
+
+func (m *memModel) GetWorkflow(ctx context.Context, workspaceId string) (*model.Workflow, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	ws, err := m.workspace(workspaceId)
+	if err != nil {
+		return nil, err
+	}
+	return copyWorkflow(ws.currentWorkflow()), nil
+}
+
+func (m *memModel) SetWorkflow(ctx context.Context, workspaceId string, workflow model.Workflow) (*model.Workflow, error) {
+	if err := workflow.Validate(); err != nil {
+		return nil, err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	ws, err := m.workspace(workspaceId)
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range ws.groups {
+		for _, t := range g.todos {
+			if !workflow.HasStatus(t.Status) {
+				return nil, model.ErrConflict(fmt.Sprintf("todos in the workspace still have status '%s' which the workflow does not define", t.Status))
+			}
+		}
+	}
+	ws.workflow = copyWorkflow(&workflow)
+	return copyWorkflow(ws.workflow), nil
+}
@@ -0,0 +1,105 @@
+package memmodel
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/astromechza/todo-app/backend/model"
+	"github.com/astromechza/todo-app/pkg/ref"
+)
+
+func (m *memModel) GetWorkspace(ctx context.Context, id string) (*model.Workspace, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	ws, err := m.workspace(id)
+	if err != nil {
+		return nil, err
+	}
+	out := ws.workspace
+	return &out, nil
+}
+
+func (m *memModel) ListWorkspaces(ctx context.Context, params model.ListWorkspacesParams) (*model.ListWorkspacesPage, error) {
+	var pageToken struct {
+		LastId string `json:"i"`
+	}
+	if err := m.pageTokens.Decode(params.PageToken, model.WorkspacesPageScope, &pageToken); err != nil {
+		return nil, err
+	}
+	limit, err := model.PageLimit(params.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	matching := make([]model.Workspace, 0)
+	for id, ws := range m.workspaces {
+		if params.VisibleTo != nil && id != model.SharedWorkspaceId {
+			if _, ok := ws.members[*params.VisibleTo]; !ok {
+				continue
+			}
+		}
+		if id > pageToken.LastId {
+			matching = append(matching, ws.workspace)
+		}
+	}
+	sort.Slice(matching, func(i, j int) bool {
+		return matching[i].Id < matching[j].Id
+	})
+
+	page := &model.ListWorkspacesPage{Items: matching}
+	if len(matching) > limit {
+		page.Items = matching[:limit]
+		page.RemainingItems = len(matching) - limit
+		pageToken.LastId = page.Items[len(page.Items)-1].Id
+		if page.NextPageToken, err = m.pageTokens.Encode(model.WorkspacesPageScope, pageToken); err != nil {
+			return nil, err
+		}
+	}
+	return page, nil
+}
+
+func (m *memModel) CreateWorkspace(ctx context.Context, params model.CreateWorkspacesParams) (*model.Workspace, error) {
+	out := model.Workspace{
+		Id:          ref.DeRefOr(params.Id, model.NewWorkspaceId()),
+		Epoch:       rand.Int63(),
+		EpochAt:     time.Now().UTC(),
+		DisplayName: params.DisplayName,
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.workspaces[out.Id]; ok {
+		return nil, model.ErrConflict(fmt.Sprintf("workspace '%s' already exists", out.Id))
+	}
+	state := &workspaceState{workspace: out, groups: make(map[string]*groupState), members: make(map[string]model.WorkspaceMember), serviceAccounts: make(map[string]model.ServiceAccount)}
+	if params.MemberId != nil {
+		if _, ok := m.users[*params.MemberId]; !ok {
+			return nil, model.ErrNotFound(fmt.Sprintf("user '%s' not found", *params.MemberId))
+		}
+		state.members[*params.MemberId] = model.WorkspaceMember{WorkspaceId: out.Id, UserId: *params.MemberId, AddedAt: out.EpochAt, Role: model.RoleOwner}
+	}
+	m.workspaces[out.Id] = state
+	return &out, nil
+}
+
+func (m *memModel) DeleteWorkspace(ctx context.Context, id string) error {
+	if id == model.SharedWorkspaceId {
+		return model.ErrBadRequest("the public workspace cannot be deleted")
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, err := m.workspace(id); err != nil {
+		return err
+	}
+	delete(m.workspaces, id)
+	maps.DeleteFunc(m.serviceTokens, func(_ string, token model.ServiceAccountToken) bool {
+		return token.WorkspaceId == id
+	})
+	return nil
+}
@@ -0,0 +1,988 @@
+// Package modeltest is a conformance test suite that every model.Modelling implementation must pass.
+package modeltest
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/astromechza/todo-app/backend/model"
+	"github.com/astromechza/todo-app/pkg/ref"
+)
+
+// Factory builds a fresh model for a single test. Implementations should register any cleanup with t.Cleanup.
+type Factory func(t *testing.T) model.Modelling
+
+// Run runs the whole conformance suite against models built by the factory.
+func Run(t *testing.T, factory Factory) {
+	tests := map[string]func(t *testing.T, m model.Modelling){
+		"workspace lifecycle":          testWorkspaceLifecycle,
+		"workspace pagination":         testWorkspacePagination,
+		"todo lifecycle":               testTodoLifecycle,
+		"todo ids are serial by group": testTodoIdSequencing,
+		"todo pagination":              testTodoPagination,
+		"todo filtering":               testTodoFiltering,
+		"todo sorting":                 testTodoSorting,
+		"todo batches":                 testTodoBatches,
+		"todo not found":               testTodoNotFound,
+		"todo revision mismatch":       testTodoRevisionMismatch,
+		"group lifecycle":              testGroupLifecycle,
+		"workflow enforcement":         testWorkflowEnforcement,
+		"todo trash":                   testTodoTrash,
+		"todo history":                 testTodoHistory,
+		"todo search":                  testTodoSearch,
+		"users and api keys":           testUsersAndApiKeys,
+		"workspace members":            testWorkspaceMembers,
+		"service accounts":             testServiceAccounts,
+		"todo assignees":               testTodoAssignees,
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r != nil {
+					if f, ok := r.(failure); ok {
+						t.Fatal(f.err)
+					}
+					panic(r)
+				}
+			}()
+			test(t, factory(t))
+		})
+	}
+}
+
+func assertErrorType[k error](t *testing.T, err error) {
+	t.Helper()
+	var target k
+	if !errors.As(err, &target) {
+		t.Fatalf("expected %T error but got %v", target, err)
+	}
+}
+
+// failure is raised by must and converted back into a test failure by Run.
+type failure struct {
+	err error
+}
+
+// must returns the value or fails the current test if the error is not nil.
+func must[k any](value k, err error) k {
+	if err != nil {
+		panic(failure{err: fmt.Errorf("unexpected error: %w", err)})
+	}
+	return value
+}
+
+// newWorkspace creates an isolated workspace for a test so that tests may share a backing database.
+func newWorkspace(t *testing.T, m model.Modelling) string {
+	t.Helper()
+	return must(m.CreateWorkspace(context.Background(), model.CreateWorkspacesParams{DisplayName: t.Name()})).Id
+}
+
+func testWorkspaceLifecycle(t *testing.T, m model.Modelling) {
+	ctx := context.Background()
+
+	public := must(m.GetWorkspace(ctx, model.SharedWorkspaceId))
+	if public.Epoch != model.DefaultWorkspaceEpoch {
+		t.Errorf("expected the public workspace to have the default epoch, got %d", public.Epoch)
+	}
+	assertErrorType[model.ErrBadRequest](t, m.DeleteWorkspace(ctx, model.SharedWorkspaceId))
+
+	id := model.NewWorkspaceId()
+	created := must(m.CreateWorkspace(ctx, model.CreateWorkspacesParams{Id: &id, DisplayName: "First"}))
+	if created.Id != id || created.DisplayName != "First" {
+		t.Errorf("unexpected workspace %+v", created)
+	}
+	if got := must(m.GetWorkspace(ctx, id)); got.Epoch != created.Epoch {
+		t.Errorf("expected epoch %d, got %d", created.Epoch, got.Epoch)
+	}
+	_, err := m.CreateWorkspace(ctx, model.CreateWorkspacesParams{Id: &id, DisplayName: "Duplicate"})
+	assertErrorType[model.ErrConflict](t, err)
+
+	must(m.CreateTodo(ctx, id, model.CreateTodosParams{GroupId: "TODO", Title: "Item"}))
+	if err := m.DeleteWorkspace(ctx, id); err != nil {
+		t.Fatal(err)
+	}
+	_, err = m.GetWorkspace(ctx, id)
+	assertErrorType[model.ErrNotFound](t, err)
+	assertErrorType[model.ErrNotFound](t, m.DeleteWorkspace(ctx, id))
+
+	recreated := must(m.CreateWorkspace(ctx, model.CreateWorkspacesParams{Id: &id, DisplayName: "Second"}))
+	if recreated.Epoch == created.Epoch {
+		t.Errorf("expected the epoch to change when the workspace is recreated")
+	}
+	_, err = m.GetTodo(ctx, id, "TODO-1")
+	assertErrorType[model.ErrNotFound](t, err)
+}
+
+func testWorkspacePagination(t *testing.T, m model.Modelling) {
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		newWorkspace(t, m)
+	}
+	seen := make(map[string]bool)
+	params := model.ListWorkspacesParams{PageSize: ref.Ref(2)}
+	for {
+		page := must(m.ListWorkspaces(ctx, params))
+		if len(page.Items) > 2 {
+			t.Fatalf("page has %d items, expected at most 2", len(page.Items))
+		}
+		for _, item := range page.Items {
+			if seen[item.Id] {
+				t.Fatalf("workspace %s returned more than once", item.Id)
+			}
+			seen[item.Id] = true
+		}
+		if params.PageToken = page.NextPageToken; params.PageToken == nil {
+			break
+		}
+	}
+	if len(seen) < 4 || !seen[model.SharedWorkspaceId] {
+		t.Errorf("expected at least the public workspace and 3 others, got %v", seen)
+	}
+}
+
+func testTodoLifecycle(t *testing.T, m model.Modelling) {
+	ctx := context.Background()
+	ws := newWorkspace(t, m)
+
+	created := must(m.CreateTodo(ctx, ws, model.CreateTodosParams{GroupId: "TODO", Title: "Do the thing", Details: ref.Ref("Details")}))
+	if created.Id != 1 || created.Revision != 0 || created.Status != model.DefaultWorkflow.InitialStatus {
+		t.Errorf("unexpected created todo %+v", created)
+	}
+	if created.Workspace.Id != ws || created.Group.Id != "TODO" {
+		t.Errorf("unexpected todo references %+v %+v", created.Workspace, created.Group)
+	}
+
+	got := must(m.GetTodo(ctx, ws, "TODO-1"))
+	if got.Title != "Do the thing" || ref.DeRefOr(got.Details, "") != "Details" || got.Epoch != created.Epoch {
+		t.Errorf("unexpected todo %+v", got)
+	}
+
+	updated := must(m.UpdateTodo(ctx, ws, "TODO-1", model.UpdateTodosParams{Revision: 0, Title: ref.Ref("Done the thing")}))
+	if updated.Revision != 1 || updated.Title != "Done the thing" || ref.DeRefOr(updated.Details, "") != "Details" {
+		t.Errorf("unexpected updated todo %+v", updated)
+	}
+	if updated.RevisionAt.Before(created.RevisionAt) {
+		t.Errorf("expected revision time to move forward")
+	}
+	// empty details clear the details rather than being ignored
+	if cleared := must(m.UpdateTodo(ctx, ws, "TODO-1", model.UpdateTodosParams{Revision: 1, Details: ref.Ref("")})); cleared.Details != nil {
+		t.Errorf("expected the details to be cleared, got %q", *cleared.Details)
+	}
+	if got := must(m.GetTodo(ctx, ws, "TODO-1")); got.Details != nil || got.Title != "Done the thing" {
+		t.Errorf("unexpected todo after clearing the details %+v", got)
+	}
+
+	if _, err := m.DeleteTodo(ctx, ws, "TODO-1", model.DeleteTodosParams{}); err != nil {
+		t.Fatal(err)
+	}
+	_, err := m.GetTodo(ctx, ws, "TODO-1")
+	assertErrorType[model.ErrNotFound](t, err)
+}
+
+func testTodoIdSequencing(t *testing.T, m model.Modelling) {
+	ctx := context.Background()
+	ws := newWorkspace(t, m)
+	expected := []string{"A-1", "A-2", "B-1", "A-3", "B-2"}
+	for _, id := range expected {
+		groupId, _ := model.SplitGroupId(id)
+		created := must(m.CreateTodo(ctx, ws, model.CreateTodosParams{GroupId: groupId, Title: id}))
+		if got := fmt.Sprintf("%s-%d", created.Group.Id, created.Id); got != id {
+			t.Errorf("expected id %s, got %s", id, got)
+		}
+	}
+	// deleting the latest todo must not cause its id to be reused
+	if _, err := m.DeleteTodo(ctx, ws, "A-3", model.DeleteTodosParams{}); err != nil {
+		t.Fatal(err)
+	}
+	if created := must(m.CreateTodo(ctx, ws, model.CreateTodosParams{GroupId: "A", Title: "next"})); created.Id != 4 {
+		t.Errorf("expected id 4 after delete, got %d", created.Id)
+	}
+}
+
+func testTodoPagination(t *testing.T, m model.Modelling) {
+	ctx := context.Background()
+	ws := newWorkspace(t, m)
+	for _, g := range []string{"B", "A", "B", "A", "C"} {
+		must(m.CreateTodo(ctx, ws, model.CreateTodosParams{GroupId: g, Title: "Item"}))
+	}
+
+	var ids []string
+	var pages int
+	params := model.ListTodosParams{PageSize: ref.Ref(2)}
+	for {
+		page := must(m.ListTodos(ctx, ws, params))
+		pages++
+		if len(page.Items) > 2 {
+			t.Fatalf("page has %d items, expected at most 2", len(page.Items))
+		}
+		for _, item := range page.Items {
+			ids = append(ids, fmt.Sprintf("%s-%d", item.Group.Id, item.Id))
+		}
+		if page.NextPageToken == nil {
+			if page.RemainingItems != 0 {
+				t.Errorf("expected no remaining items on the last page, got %d", page.RemainingItems)
+			}
+			break
+		} else if page.RemainingItems != 5-len(ids) {
+			t.Errorf("expected %d remaining items, got %d", 5-len(ids), page.RemainingItems)
+		}
+		params.PageToken = page.NextPageToken
+	}
+	if fmt.Sprint(ids) != "[A-1 A-2 B-1 B-2 C-1]" || pages != 3 {
+		t.Errorf("unexpected ids %v over %d pages", ids, pages)
+	}
+
+	_, err := m.ListTodos(ctx, ws, model.ListTodosParams{PageToken: ref.Ref("not a token!")})
+	assertErrorType[model.ErrBadRequest](t, err)
+	_, err = m.ListTodos(ctx, ws, model.ListTodosParams{PageSize: ref.Ref(0)})
+	assertErrorType[model.ErrBadRequest](t, err)
+
+	// page tokens are signed, and only valid for the list that issued them
+	first := must(m.ListTodos(ctx, ws, model.ListTodosParams{PageSize: ref.Ref(2)}))
+	payload, signature, _ := strings.Cut(*first.NextPageToken, ".")
+	raw := must(base64.RawURLEncoding.DecodeString(payload))
+	forged := base64.RawURLEncoding.EncodeToString(bytes.Replace(raw, []byte(`"i":2`), []byte(`"i":0`), 1)) + "." + signature
+	_, err = m.ListTodos(ctx, ws, model.ListTodosParams{PageToken: &forged})
+	assertErrorType[model.ErrBadRequest](t, err)
+	_, err = m.ListTodos(ctx, newWorkspace(t, m), model.ListTodosParams{PageToken: first.NextPageToken})
+	assertErrorType[model.ErrBadRequest](t, err)
+}
+
+func testTodoFiltering(t *testing.T, m model.Modelling) {
+	ctx := context.Background()
+	ws := newWorkspace(t, m)
+	other := newWorkspace(t, m)
+	must(m.CreateTodo(ctx, other, model.CreateTodosParams{GroupId: "A", Title: "Other workspace"}))
+	for _, g := range []string{"A", "A", "B"} {
+		// the todos are created a little apart so that they can be told apart by their creation time
+		time.Sleep(2 * time.Millisecond)
+		must(m.CreateTodo(ctx, ws, model.CreateTodosParams{GroupId: g, Title: "Item"}))
+	}
+	time.Sleep(2 * time.Millisecond)
+	must(m.UpdateTodo(ctx, ws, "A-2", model.UpdateTodosParams{Revision: 0, Status: ref.Ref("done")}))
+	// the times are read back from the model since it may store them at a lower precision
+	all := must(m.ListTodos(ctx, ws, model.ListTodosParams{})).Items
+	if len(all) != 3 {
+		t.Fatalf("expected 3 todos, got %d", len(all))
+	}
+
+	cases := []struct {
+		filter   model.TodoFilter
+		expected string
+	}{
+		{model.TodoFilter{}, "[A-1 A-2 B-1]"},
+		{model.TodoFilter{ByStatus: []string{"done"}}, "[A-2]"},
+		{model.TodoFilter{ByStatus: []string{"open", "done"}}, "[A-1 A-2 B-1]"},
+		{model.TodoFilter{ByGroup: []string{"B"}}, "[B-1]"},
+		{model.TodoFilter{ByGroup: []string{"A"}, ByStatus: []string{"open"}}, "[A-1]"},
+		{model.TodoFilter{ByStatus: []string{"in_progress"}}, "[]"},
+		{model.TodoFilter{CreatedAfter: &all[1].EpochAt}, "[A-2 B-1]"},
+		{model.TodoFilter{CreatedBefore: &all[1].EpochAt}, "[A-1]"},
+		{model.TodoFilter{CreatedAfter: &all[0].EpochAt, CreatedBefore: &all[2].EpochAt}, "[A-1 A-2]"},
+		{model.TodoFilter{UpdatedAfter: &all[1].RevisionAt}, "[A-2]"},
+		{model.TodoFilter{UpdatedBefore: &all[1].RevisionAt, ByGroup: []string{"A"}}, "[A-1]"},
+	}
+	for _, c := range cases {
+		page := must(m.ListTodos(ctx, ws, model.ListTodosParams{TodoFilter: c.filter}))
+		ids := make([]string, len(page.Items))
+		for i, item := range page.Items {
+			ids[i] = fmt.Sprintf("%s-%d", item.Group.Id, item.Id)
+		}
+		if fmt.Sprint(ids) != c.expected {
+			t.Errorf("filter %+v: expected %s, got %v", c.filter, c.expected, ids)
+		}
+	}
+
+	_, err := m.ListTodos(ctx, ws, model.ListTodosParams{TodoFilter: model.TodoFilter{CreatedAfter: &all[1].EpochAt, CreatedBefore: &all[0].EpochAt}})
+	assertErrorType[model.ErrBadRequest](t, err)
+
+	// the following pages keep the filter of the first page, and reject a different one
+	first := must(m.ListTodos(ctx, ws, model.ListTodosParams{TodoFilter: model.TodoFilter{ByGroup: []string{"A"}}, PageSize: ref.Ref(1)}))
+	if first.NextPageToken == nil || first.RemainingItems != 1 {
+		t.Fatalf("expected a next page with 1 remaining item, got %+v", first)
+	}
+	second := must(m.ListTodos(ctx, ws, model.ListTodosParams{PageToken: first.NextPageToken}))
+	if len(second.Items) != 1 || second.Items[0].Group.Id != "A" || second.NextPageToken != nil {
+		t.Errorf("expected the last todo in group A, got %+v", second)
+	}
+	must(m.ListTodos(ctx, ws, model.ListTodosParams{TodoFilter: model.TodoFilter{ByGroup: []string{"A", "A"}}, PageToken: first.NextPageToken}))
+	_, err = m.ListTodos(ctx, ws, model.ListTodosParams{TodoFilter: model.TodoFilter{ByGroup: []string{"B"}}, PageToken: first.NextPageToken})
+	assertErrorType[model.ErrBadRequest](t, err)
+	_, err = m.ListTodos(ctx, ws, model.ListTodosParams{TodoFilter: model.TodoFilter{Trashed: true}, PageToken: first.NextPageToken})
+	assertErrorType[model.ErrBadRequest](t, err)
+}
+
+func testTodoSorting(t *testing.T, m model.Modelling) {
+	ctx := context.Background()
+	ws := newWorkspace(t, m)
+	for _, c := range []struct{ group, title string }{{"A", "cherry"}, {"A", "apple"}, {"B", "banana"}, {"B", "apple"}} {
+		// the todos are created and updated a little apart so that they can be told apart by their times
+		time.Sleep(2 * time.Millisecond)
+		must(m.CreateTodo(ctx, ws, model.CreateTodosParams{GroupId: c.group, Title: c.title}))
+	}
+	time.Sleep(2 * time.Millisecond)
+	must(m.UpdateTodo(ctx, ws, "A-1", model.UpdateTodosParams{Revision: 0, Status: ref.Ref("done")}))
+
+	// list pages through the todos of the workspace two at a time
+	list := func(ws string, params model.ListTodosParams) []string {
+		params.PageSize = ref.Ref(2)
+		ids := make([]string, 0)
+		for {
+			page := must(m.ListTodos(ctx, ws, params))
+			for _, item := range page.Items {
+				ids = append(ids, fmt.Sprintf("%s-%d", item.Group.Id, item.Id))
+			}
+			if page.NextPageToken == nil {
+				return ids
+			}
+			params = model.ListTodosParams{PageToken: page.NextPageToken}
+		}
+	}
+	cases := []struct {
+		sort     model.TodoSort
+		expected string
+	}{
+		{model.TodoSort{}, "[A-1 A-2 B-1 B-2]"},
+		{model.TodoSort{Field: model.TodoSortCreatedAt}, "[A-1 A-2 B-1 B-2]"},
+		{model.TodoSort{Field: model.TodoSortCreatedAt, Descending: true}, "[B-2 B-1 A-2 A-1]"},
+		{model.TodoSort{Field: model.TodoSortUpdatedAt}, "[A-2 B-1 B-2 A-1]"},
+		{model.TodoSort{Field: model.TodoSortUpdatedAt, Descending: true}, "[A-1 B-2 B-1 A-2]"},
+		{model.TodoSort{Field: model.TodoSortTitle}, "[A-2 B-2 B-1 A-1]"},
+		{model.TodoSort{Field: model.TodoSortTitle, Descending: true}, "[A-1 B-1 A-2 B-2]"},
+	}
+	for _, c := range cases {
+		if ids := list(ws, model.ListTodosParams{Sort: c.sort}); fmt.Sprint(ids) != c.expected {
+			t.Errorf("sort %+v: expected %s, got %v", c.sort, c.expected, ids)
+		}
+	}
+	if ids := list(ws, model.ListTodosParams{TodoFilter: model.TodoFilter{Query: ref.Ref("apple")}, Sort: model.TodoSort{Field: model.TodoSortTitle, Descending: true}}); fmt.Sprint(ids) != "[A-2 B-2]" {
+		t.Errorf("expected the search to be sorted by title, got %v", ids)
+	}
+
+	// titles are compared lower-cased whatever the collation of the database, with ties broken by group and id even
+	// when they span pages
+	mixed := newWorkspace(t, m)
+	for _, title := range []string{"banana", "Apple", "apple", "Cherry", "Éclair", "avocado", "APPLE"} {
+		must(m.CreateTodo(ctx, mixed, model.CreateTodosParams{GroupId: "C", Title: title}))
+	}
+	if ids := list(mixed, model.ListTodosParams{Sort: model.TodoSort{Field: model.TodoSortTitle}}); fmt.Sprint(ids) != "[C-2 C-3 C-7 C-6 C-1 C-4 C-5]" {
+		t.Errorf("unexpected order of mixed case titles %v", ids)
+	}
+	if ids := list(mixed, model.ListTodosParams{Sort: model.TodoSort{Field: model.TodoSortTitle, Descending: true}}); fmt.Sprint(ids) != "[C-5 C-4 C-1 C-6 C-2 C-3 C-7]" {
+		t.Errorf("unexpected descending order of mixed case titles %v", ids)
+	}
+
+	// a todo updated while paging by update time moves to the end rather than disturbing the todos in between
+	first := must(m.ListTodos(ctx, ws, model.ListTodosParams{Sort: model.TodoSort{Field: model.TodoSortUpdatedAt}, PageSize: ref.Ref(2)}))
+	time.Sleep(2 * time.Millisecond)
+	must(m.UpdateTodo(ctx, ws, "A-2", model.UpdateTodosParams{Revision: 0, Title: ref.Ref("apricot")}))
+	rest := list(ws, model.ListTodosParams{PageToken: first.NextPageToken})
+	if fmt.Sprint(rest) != "[B-2 A-1 A-2]" {
+		t.Errorf("expected the updated todo at the end of the following pages, got %v", rest)
+	}
+
+	_, err := m.ListTodos(ctx, ws, model.ListTodosParams{Sort: model.TodoSort{Field: model.TodoSortTitle}, PageToken: first.NextPageToken})
+	assertErrorType[model.ErrBadRequest](t, err)
+	_, err = m.ListTodos(ctx, ws, model.ListTodosParams{Sort: model.TodoSort{Descending: true}})
+	assertErrorType[model.ErrBadRequest](t, err)
+	_, err = m.ListTodos(ctx, ws, model.ListTodosParams{Sort: model.TodoSort{Field: "status"}})
+	assertErrorType[model.ErrBadRequest](t, err)
+}
+
+func testTodoBatches(t *testing.T, m model.Modelling) {
+	ctx := context.Background()
+	ws := newWorkspace(t, m)
+	listIds := func() string {
+		page := must(m.ListTodos(ctx, ws, model.ListTodosParams{}))
+		ids := make([]string, len(page.Items))
+		for i, item := range page.Items {
+			ids[i] = fmt.Sprintf("%s-%d", item.Group.Id, item.Id)
+		}
+		return fmt.Sprint(ids)
+	}
+
+	// a failing operation undoes the whole batch, including the serials claimed by its creates
+	_, err := m.BatchTodos(ctx, ws, model.BatchTodosParams{Operations: []model.TodoOperation{
+		{Kind: model.TodoOperationCreate, Create: model.CreateTodosParams{GroupId: "A", Title: "First"}},
+		{Kind: model.TodoOperationUpdate, TodoId: "A-1", Update: model.UpdateTodosParams{Revision: 5, Title: ref.Ref("Stale")}},
+	}})
+	var batchErr *model.BatchError
+	if !errors.As(err, &batchErr) || batchErr.Index != 1 {
+		t.Fatalf("expected a batch error for operation 1, got %v", err)
+	}
+	assertErrorType[model.ErrConflict](t, err)
+	if ids := listIds(); ids != "[]" {
+		t.Fatalf("expected the failed batch to leave no todos, got %s", ids)
+	}
+
+	results := must(m.BatchTodos(ctx, ws, model.BatchTodosParams{Operations: []model.TodoOperation{
+		{Kind: model.TodoOperationCreate, Create: model.CreateTodosParams{GroupId: "A", Title: "First"}},
+		{Kind: model.TodoOperationCreate, Create: model.CreateTodosParams{GroupId: "A", Title: "Second"}},
+		{Kind: model.TodoOperationUpdate, TodoId: "A-1", Update: model.UpdateTodosParams{Revision: 0, Status: ref.Ref("done")}},
+		{Kind: model.TodoOperationDelete, TodoId: "A-2"},
+	}}))
+	if len(results) != 4 || results[0].Todo == nil || results[0].Todo.Id != 1 || results[2].Todo.Status != "done" || results[3].Todo == nil || results[3].Todo.DeletedAt == nil || results[3].Todo.Revision != 1 {
+		t.Fatalf("unexpected batch results %+v", results)
+	}
+	if ids := listIds(); ids != "[A-1]" {
+		t.Errorf("expected only A-1 after the batch, got %s", ids)
+	}
+	if history := must(m.ListTodoHistory(ctx, ws, "A-1")); len(history) != 2 {
+		t.Errorf("expected the batch to record 2 revisions of A-1, got %d", len(history))
+	}
+
+	// a partial batch applies the operations that succeed and reports the others
+	results = must(m.BatchTodos(ctx, ws, model.BatchTodosParams{Partial: true, Operations: []model.TodoOperation{
+		{Kind: model.TodoOperationCreate, Create: model.CreateTodosParams{GroupId: "B", Title: "Third"}},
+		{Kind: model.TodoOperationUpdate, TodoId: "A-9", Update: model.UpdateTodosParams{Revision: 0, Title: ref.Ref("Missing")}},
+		{Kind: model.TodoOperationDelete, TodoId: "A-1", Delete: model.DeleteTodosParams{Revision: ref.Ref(int64(1))}},
+		{Kind: model.TodoOperationDelete},
+	}}))
+	if results[0].Err != nil || results[2].Err != nil {
+		t.Fatalf("unexpected errors in partial batch %+v", results)
+	}
+	assertErrorType[model.ErrNotFound](t, results[1].Err)
+	assertErrorType[model.ErrBadRequest](t, results[3].Err)
+	if ids := listIds(); ids != "[B-1]" {
+		t.Errorf("expected only B-1 after the partial batch, got %s", ids)
+	}
+
+	_, err = m.BatchTodos(ctx, ws, model.BatchTodosParams{})
+	assertErrorType[model.ErrBadRequest](t, err)
+}
+
+func testTodoNotFound(t *testing.T, m model.Modelling) {
+	ctx := context.Background()
+	ws := newWorkspace(t, m)
+	must(m.CreateTodo(ctx, ws, model.CreateTodosParams{GroupId: "A", Title: "Item"}))
+
+	_, err := m.GetTodo(ctx, ws, "A-2")
+	assertErrorType[model.ErrNotFound](t, err)
+	_, err = m.GetTodo(ctx, ws, "B-1")
+	assertErrorType[model.ErrNotFound](t, err)
+	_, err = m.GetTodo(ctx, model.SharedWorkspaceId, "A-1")
+	assertErrorType[model.ErrNotFound](t, err)
+	_, err = m.UpdateTodo(ctx, ws, "A-2", model.UpdateTodosParams{Title: ref.Ref("Nope")})
+	assertErrorType[model.ErrNotFound](t, err)
+	_, err = m.DeleteTodo(ctx, ws, "A-2", model.DeleteTodosParams{})
+	assertErrorType[model.ErrNotFound](t, err)
+	_, err = m.CreateTodo(ctx, "missing123", model.CreateTodosParams{GroupId: "A", Title: "Item"})
+	assertErrorType[model.ErrNotFound](t, err)
+	_, err = m.ListTodos(ctx, "missing123", model.ListTodosParams{})
+	assertErrorType[model.ErrNotFound](t, err)
+}
+
+func testTodoRevisionMismatch(t *testing.T, m model.Modelling) {
+	ctx := context.Background()
+	ws := newWorkspace(t, m)
+	must(m.CreateTodo(ctx, ws, model.CreateTodosParams{GroupId: "A", Title: "Item"}))
+	must(m.UpdateTodo(ctx, ws, "A-1", model.UpdateTodosParams{Revision: 0, Title: ref.Ref("First")}))
+
+	_, err := m.UpdateTodo(ctx, ws, "A-1", model.UpdateTodosParams{Revision: 0, Title: ref.Ref("Second")})
+	assertErrorType[model.ErrConflict](t, err)
+	if got := must(m.GetTodo(ctx, ws, "A-1")); got.Title != "First" || got.Revision != 1 {
+		t.Errorf("expected the stale update to be rejected, got %+v", got)
+	}
+
+	current := must(m.GetTodo(ctx, ws, "A-1"))
+	_, err = m.UpdateTodo(ctx, ws, "A-1", model.UpdateTodosParams{Revision: 1, Epoch: ref.Ref(current.Epoch + 1), Title: ref.Ref("Second")})
+	assertErrorType[model.ErrPreconditionFailed](t, err)
+	_, err = m.UpdateTodo(ctx, ws, "A-1", model.UpdateTodosParams{Revision: 0, Epoch: ref.Ref(current.Epoch), Title: ref.Ref("Second")})
+	assertErrorType[model.ErrPreconditionFailed](t, err)
+	must(m.UpdateTodo(ctx, ws, "A-1", model.UpdateTodosParams{Revision: 1, Epoch: ref.Ref(current.Epoch), Title: ref.Ref("Second")}))
+
+	_, err = m.DeleteTodo(ctx, ws, "A-1", model.DeleteTodosParams{Revision: ref.Ref[int64](5)})
+	assertErrorType[model.ErrPreconditionFailed](t, err)
+	_, err = m.DeleteTodo(ctx, ws, "A-1", model.DeleteTodosParams{Epoch: ref.Ref(current.Epoch + 1)})
+	assertErrorType[model.ErrPreconditionFailed](t, err)
+	must(m.GetTodo(ctx, ws, "A-1"))
+	if _, err := m.DeleteTodo(ctx, ws, "A-1", model.DeleteTodosParams{Epoch: ref.Ref(current.Epoch), Revision: ref.Ref[int64](2)}); err != nil {
+		t.Errorf("expected delete with the current epoch and revision to succeed: %v", err)
+	}
+}
+
+func testGroupLifecycle(t *testing.T, m model.Modelling) {
+	ctx := context.Background()
+	ws := newWorkspace(t, m)
+
+	created := must(m.CreateGroup(ctx, ws, model.CreateGroupsParams{Id: "OPS", DisplayName: ref.Ref("Operations"), NextSerial: ref.Ref[int64](100)}))
+	if created.LastSerial != 99 || ref.DeRefOr(created.DisplayName, "") != "Operations" {
+		t.Errorf("unexpected group %+v", created)
+	}
+	_, err := m.CreateGroup(ctx, ws, model.CreateGroupsParams{Id: "OPS"})
+	assertErrorType[model.ErrConflict](t, err)
+
+	if todo := must(m.CreateTodo(ctx, ws, model.CreateTodosParams{GroupId: "OPS", Title: "Item"})); todo.Id != 100 || todo.Group.Epoch != created.Epoch {
+		t.Errorf("unexpected todo in group %+v", todo)
+	}
+	must(m.CreateTodo(ctx, ws, model.CreateTodosParams{GroupId: "IMPLICIT", Title: "Item"}))
+
+	groups := must(m.ListGroups(ctx, ws))
+	if len(groups) != 2 || groups[0].Id != "IMPLICIT" || groups[1].Id != "OPS" || groups[1].TodoCount != 1 || groups[1].LastSerial != 100 {
+		t.Errorf("unexpected groups %+v", groups)
+	}
+
+	renamed := must(m.UpdateGroup(ctx, ws, "OPS", model.UpdateGroupsParams{DisplayName: ref.Ref("Ops")}))
+	if ref.DeRefOr(renamed.DisplayName, "") != "Ops" || renamed.Epoch != created.Epoch {
+		t.Errorf("unexpected renamed group %+v", renamed)
+	}
+	_, err = m.UpdateGroup(ctx, ws, "OPS", model.UpdateGroupsParams{NextSerial: ref.Ref[int64](50)})
+	assertErrorType[model.ErrBadRequest](t, err)
+
+	// deleting the group returns its active todos but not those in the trash
+	must(m.CreateTodo(ctx, ws, model.CreateTodosParams{GroupId: "OPS", Title: "Trashed"}))
+	must(m.DeleteTodo(ctx, ws, "OPS-101", model.DeleteTodosParams{}))
+	if deleted := must(m.DeleteGroup(ctx, ws, "OPS")); len(deleted) != 1 || deleted[0].Id != 100 || deleted[0].Group.Id != "OPS" {
+		t.Errorf("unexpected todos of the deleted group %+v", deleted)
+	}
+	_, err = m.GetTodo(ctx, ws, "OPS-100")
+	assertErrorType[model.ErrNotFound](t, err)
+	_, err = m.DeleteGroup(ctx, ws, "OPS")
+	assertErrorType[model.ErrNotFound](t, err)
+
+	recreated := must(m.CreateGroup(ctx, ws, model.CreateGroupsParams{Id: "OPS"}))
+	if recreated.Epoch == created.Epoch || recreated.LastSerial != 0 {
+		t.Errorf("expected a new epoch and counter for the recreated group, got %+v", recreated)
+	}
+}
+
+func testWorkflowEnforcement(t *testing.T, m model.Modelling) {
+	ctx := context.Background()
+	ws := newWorkspace(t, m)
+
+	if wf := must(m.GetWorkflow(ctx, ws)); wf.InitialStatus != model.DefaultWorkflow.InitialStatus {
+		t.Errorf("expected the default workflow, got %+v", wf)
+	}
+	must(m.CreateTodo(ctx, ws, model.CreateTodosParams{GroupId: "A", Title: "Item"}))
+
+	_, err := m.SetWorkflow(ctx, ws, model.Workflow{InitialStatus: "todo", Statuses: []string{"todo"}})
+	assertErrorType[model.ErrConflict](t, err)
+	_, err = m.SetWorkflow(ctx, ws, model.Workflow{InitialStatus: "missing", Statuses: []string{"open"}})
+	assertErrorType[model.ErrBadRequest](t, err)
+
+	must(m.SetWorkflow(ctx, ws, model.Workflow{
+		InitialStatus: "open",
+		Statuses:      []string{"open", "review", "closed"},
+		Transitions:   map[string][]string{"open": {"review"}, "review": {"open", "closed"}},
+	}))
+	if created := must(m.CreateTodo(ctx, ws, model.CreateTodosParams{GroupId: "A", Title: "Item"})); created.Status != "open" {
+		t.Errorf("expected the initial status, got %s", created.Status)
+	}
+
+	_, err = m.UpdateTodo(ctx, ws, "A-1", model.UpdateTodosParams{Revision: 0, Status: ref.Ref("done")})
+	assertErrorType[model.ErrBadRequest](t, err)
+	_, err = m.UpdateTodo(ctx, ws, "A-1", model.UpdateTodosParams{Revision: 0, Status: ref.Ref("closed")})
+	assertErrorType[model.ErrConflict](t, err)
+	must(m.UpdateTodo(ctx, ws, "A-1", model.UpdateTodosParams{Revision: 0, Status: ref.Ref("review")}))
+	if got := must(m.UpdateTodo(ctx, ws, "A-1", model.UpdateTodosParams{Revision: 1, Status: ref.Ref("closed")})); got.Status != "closed" {
+		t.Errorf("unexpected status %s", got.Status)
+	}
+	// the closed status has no outgoing transitions, but other fields may still be changed
+	_, err = m.UpdateTodo(ctx, ws, "A-1", model.UpdateTodosParams{Revision: 2, Status: ref.Ref("open")})
+	assertErrorType[model.ErrConflict](t, err)
+	must(m.UpdateTodo(ctx, ws, "A-1", model.UpdateTodosParams{Revision: 2, Status: ref.Ref("closed"), Title: ref.Ref("Renamed")}))
+}
+
+func testTodoTrash(t *testing.T, m model.Modelling) {
+	ctx := context.Background()
+	ws := newWorkspace(t, m)
+	for i := 0; i < 3; i++ {
+		must(m.CreateTodo(ctx, ws, model.CreateTodosParams{GroupId: "A", Title: "Item"}))
+	}
+	if trashed := must(m.DeleteTodo(ctx, ws, "A-2", model.DeleteTodosParams{})); trashed.Id != 2 || trashed.DeletedAt == nil || trashed.Revision != 1 {
+		t.Errorf("unexpected trashed todo %+v", trashed)
+	}
+
+	_, err := m.GetTodo(ctx, ws, "A-2")
+	assertErrorType[model.ErrNotFound](t, err)
+	_, err = m.UpdateTodo(ctx, ws, "A-2", model.UpdateTodosParams{Revision: 1, Title: ref.Ref("Nope")})
+	assertErrorType[model.ErrNotFound](t, err)
+	_, err = m.DeleteTodo(ctx, ws, "A-2", model.DeleteTodosParams{})
+	assertErrorType[model.ErrNotFound](t, err)
+	if page := must(m.ListTodos(ctx, ws, model.ListTodosParams{})); len(page.Items) != 2 {
+		t.Errorf("expected the trashed todo to be excluded, got %d items", len(page.Items))
+	}
+	if g := must(m.GetGroup(ctx, ws, "A")); g.TodoCount != 2 {
+		t.Errorf("expected the trashed todo to be excluded from the count, got %d", g.TodoCount)
+	}
+	trash := must(m.ListTodos(ctx, ws, model.ListTodosParams{TodoFilter: model.TodoFilter{Trashed: true}}))
+	if len(trash.Items) != 1 || trash.Items[0].Id != 2 || trash.Items[0].DeletedAt == nil {
+		t.Fatalf("unexpected trash %+v", trash.Items)
+	}
+
+	_, err = m.RestoreTodo(ctx, ws, "A-1")
+	assertErrorType[model.ErrNotFound](t, err)
+	restored := must(m.RestoreTodo(ctx, ws, "A-2"))
+	if restored.Id != 2 || restored.DeletedAt != nil || restored.Revision != 2 {
+		t.Errorf("unexpected restored todo %+v", restored)
+	}
+	must(m.GetTodo(ctx, ws, "A-2"))
+
+	// only todos deleted before the cutoff are purged
+	if _, err := m.DeleteTodo(ctx, ws, "A-1", model.DeleteTodosParams{}); err != nil {
+		t.Fatal(err)
+	}
+	if purged := must(m.PurgeTrash(ctx, time.Now().Add(-time.Hour))); purged != 0 {
+		t.Errorf("expected nothing to be purged, got %d", purged)
+	}
+	if purged := must(m.PurgeTrash(ctx, time.Now().Add(time.Second))); purged < 1 {
+		t.Errorf("expected the trashed todo to be purged, got %d", purged)
+	}
+	_, err = m.RestoreTodo(ctx, ws, "A-1")
+	assertErrorType[model.ErrNotFound](t, err)
+	if created := must(m.CreateTodo(ctx, ws, model.CreateTodosParams{GroupId: "A", Title: "Item"})); created.Id != 4 {
+		t.Errorf("expected purged ids not to be reused, got %d", created.Id)
+	}
+}
+
+func testTodoHistory(t *testing.T, m model.Modelling) {
+	ctx := context.Background()
+	ws := newWorkspace(t, m)
+	must(m.CreateTodo(ctx, ws, model.CreateTodosParams{GroupId: "A", Title: "First"}))
+	userCtx := model.ContextWithActor(ctx, model.Actor{Kind: model.ActorKindUser, Id: "alice"})
+	must(m.UpdateTodo(userCtx, ws, "A-1", model.UpdateTodosParams{Revision: 0, Title: ref.Ref("Second"), Details: ref.Ref("More")}))
+	must(m.UpdateTodo(ctx, ws, "A-1", model.UpdateTodosParams{Revision: 1, Title: ref.Ref("Second"), Status: ref.Ref("done")}))
+	serviceCtx := model.ContextWithActor(ctx, model.Actor{Kind: model.ActorKindServiceAccount, Id: "robot"})
+	if _, err := m.DeleteTodo(serviceCtx, ws, "A-1", model.DeleteTodosParams{}); err != nil {
+		t.Fatal(err)
+	}
+	must(m.RestoreTodo(ctx, ws, "A-1"))
+
+	history := must(m.ListTodoHistory(ctx, ws, "A-1"))
+	summary := make([]string, len(history))
+	for i, r := range history {
+		summary[i] = fmt.Sprintf("%d:%s", r.Revision, r.Action)
+		for _, c := range r.Changes {
+			summary[i] += fmt.Sprintf(" %s=%s->%s", c.Field, ref.DeRefOr(c.From, "nil"), ref.DeRefOr(c.To, "nil"))
+		}
+		if r.Actor != nil {
+			summary[i] += fmt.Sprintf(" by %s:%s", r.Actor.Kind, r.Actor.Id)
+		}
+		if i > 0 && r.RevisionAt.Before(history[i-1].RevisionAt) {
+			t.Errorf("expected revisions to be in time order")
+		}
+	}
+	expected := "[0:created title=nil->First status=nil->open 1:updated title=First->Second details=nil->More by user:alice 2:updated status=open->done 3:deleted by service_account:robot 4:restored]"
+	if fmt.Sprint(summary) != expected {
+		t.Errorf("unexpected history\n%v\nexpected\n%v", summary, expected)
+	}
+
+	_, err := m.ListTodoHistory(ctx, ws, "A-2")
+	assertErrorType[model.ErrNotFound](t, err)
+}
+
+func testTodoSearch(t *testing.T, m model.Modelling) {
+	ctx := context.Background()
+	ws := newWorkspace(t, m)
+	for _, c := range []model.CreateTodosParams{
+		{GroupId: "A", Title: "Buy milk", Details: ref.Ref("From the shop")},
+		{GroupId: "A", Title: "Call mum", Details: ref.Ref("Ask about the milk")},
+		{GroupId: "A", Title: "Walk the dog"},
+		{GroupId: "B", Title: "Milk delivery", Details: ref.Ref("Return the milk crates")},
+	} {
+		must(m.CreateTodo(ctx, ws, c))
+	}
+
+	search := func(query string, pageSize int) []string {
+		var ids []string
+		params := model.ListTodosParams{TodoFilter: model.TodoFilter{Query: ref.Ref(query)}, PageSize: ref.Ref(pageSize)}
+		for {
+			page := must(m.ListTodos(ctx, ws, params))
+			for _, item := range page.Items {
+				ids = append(ids, fmt.Sprintf("%s-%d", item.Group.Id, item.Id))
+			}
+			if params.PageToken = page.NextPageToken; params.PageToken == nil {
+				return ids
+			}
+		}
+	}
+	// matches in both the title and details rank above matches in the title, which rank above matches in the details
+	if ids := search("milk", 10); fmt.Sprint(ids) != "[B-1 A-1 A-2]" {
+		t.Errorf("unexpected results %v", ids)
+	}
+	if ids := search("MILK", 1); fmt.Sprint(ids) != "[B-1 A-1 A-2]" {
+		t.Errorf("unexpected paginated results %v", ids)
+	}
+	if ids := search("milk shop", 10); fmt.Sprint(ids) != "[A-1]" {
+		t.Errorf("expected every term to match, got %v", ids)
+	}
+	if ids := search("unicorn", 10); len(ids) != 0 {
+		t.Errorf("expected no results, got %v", ids)
+	}
+	page := must(m.ListTodos(ctx, ws, model.ListTodosParams{TodoFilter: model.TodoFilter{Query: ref.Ref("milk"), ByGroup: []string{"A"}}, PageSize: ref.Ref(1)}))
+	if len(page.Items) != 1 || page.Items[0].Id != 1 || page.RemainingItems != 1 {
+		t.Errorf("unexpected filtered search page %+v", page)
+	}
+}
+
+func testUsersAndApiKeys(t *testing.T, m model.Modelling) {
+	ctx := context.Background()
+	user := must(m.CreateUser(ctx, model.CreateUsersParams{DisplayName: "Alice"}))
+	if got := must(m.GetUser(ctx, user.Id)); got.DisplayName != "Alice" {
+		t.Errorf("unexpected user %+v", got)
+	}
+	_, err := m.CreateUser(ctx, model.CreateUsersParams{Id: &user.Id, DisplayName: "Duplicate"})
+	assertErrorType[model.ErrConflict](t, err)
+	_, err = m.GetUser(ctx, model.NewUserId())
+	assertErrorType[model.ErrNotFound](t, err)
+
+	key := must(m.CreateApiKey(ctx, user.Id, model.CreateApiKeysParams{Name: "laptop"}))
+	if !model.IsApiKey(key.Secret) || key.UserId != user.Id {
+		t.Fatalf("unexpected api key %+v", key)
+	}
+	if got := must(m.GetUserByApiKey(ctx, key.Secret)); got.Id != user.Id {
+		t.Errorf("expected the api key to belong to %s, got %s", user.Id, got.Id)
+	}
+	_, err = m.GetUserByApiKey(ctx, key.Secret+"x")
+	assertErrorType[model.ErrNotFound](t, err)
+	if keys := must(m.ListApiKeys(ctx, user.Id)); len(keys) != 1 || keys[0].Id != key.Id || keys[0].Name != "laptop" || keys[0].Secret != "" {
+		t.Errorf("unexpected api keys %+v", keys)
+	}
+
+	other := must(m.CreateUser(ctx, model.CreateUsersParams{DisplayName: "Bob"}))
+	assertErrorType[model.ErrNotFound](t, m.DeleteApiKey(ctx, other.Id, key.Id))
+	if err := m.DeleteApiKey(ctx, user.Id, key.Id); err != nil {
+		t.Fatal(err)
+	}
+	_, err = m.GetUserByApiKey(ctx, key.Secret)
+	assertErrorType[model.ErrNotFound](t, err)
+
+	identity := model.UserIdentity{Issuer: "https://idp.example.com", Subject: user.Id}
+	_, err = m.GetUserByIdentity(ctx, identity)
+	assertErrorType[model.ErrNotFound](t, err)
+	linked := must(m.CreateUser(ctx, model.CreateUsersParams{DisplayName: "Carol", Identity: &identity}))
+	if got := must(m.GetUserByIdentity(ctx, identity)); got.Id != linked.Id {
+		t.Errorf("expected the identity to be linked to %s, got %s", linked.Id, got.Id)
+	}
+	_, err = m.CreateUser(ctx, model.CreateUsersParams{DisplayName: "Mallory", Identity: &identity})
+	assertErrorType[model.ErrConflict](t, err)
+}
+
+func testWorkspaceMembers(t *testing.T, m model.Modelling) {
+	ctx := context.Background()
+	alice := must(m.CreateUser(ctx, model.CreateUsersParams{DisplayName: "Alice"}))
+	bob := must(m.CreateUser(ctx, model.CreateUsersParams{DisplayName: "Bob"}))
+	ws := must(m.CreateWorkspace(ctx, model.CreateWorkspacesParams{DisplayName: t.Name(), MemberId: &alice.Id}))
+
+	if owner := must(m.GetWorkspaceMember(ctx, ws.Id, alice.Id)); owner.Role != model.RoleOwner {
+		t.Errorf("expected the creator to be an owner, got %s", owner.Role)
+	}
+	_, err := m.GetWorkspaceMember(ctx, ws.Id, bob.Id)
+	assertErrorType[model.ErrNotFound](t, err)
+	viewer := model.SetWorkspaceMemberParams{Role: model.RoleViewer}
+	_, err = m.SetWorkspaceMember(ctx, model.SharedWorkspaceId, bob.Id, viewer)
+	assertErrorType[model.ErrBadRequest](t, err)
+	_, err = m.SetWorkspaceMember(ctx, ws.Id, model.NewUserId(), viewer)
+	assertErrorType[model.ErrNotFound](t, err)
+	_, err = m.SetWorkspaceMember(ctx, ws.Id, bob.Id, model.SetWorkspaceMemberParams{Role: "admin"})
+	assertErrorType[model.ErrBadRequest](t, err)
+	_, err = m.SetWorkspaceMember(ctx, ws.Id, bob.Id, model.SetWorkspaceMemberParams{Role: model.RoleViewer, GroupRoles: map[string]string{"OPS": "admin"}})
+	assertErrorType[model.ErrBadRequest](t, err)
+
+	added := must(m.SetWorkspaceMember(ctx, ws.Id, bob.Id, viewer))
+	updated := must(m.SetWorkspaceMember(ctx, ws.Id, bob.Id, model.SetWorkspaceMemberParams{Role: model.RoleCommenter, GroupRoles: map[string]string{"OPS": model.RoleEditor}}))
+	if !updated.AddedAt.Equal(added.AddedAt) || updated.Role != model.RoleCommenter || updated.RoleIn("OPS") != model.RoleEditor || updated.RoleIn("TODO") != model.RoleCommenter {
+		t.Errorf("unexpected updated member %+v", updated)
+	}
+	if members := must(m.ListWorkspaceMembers(ctx, ws.Id)); len(members) != 2 {
+		t.Errorf("expected 2 members, got %+v", members)
+	}
+	if got := must(m.GetWorkspaceMember(ctx, ws.Id, bob.Id)); got.GroupRoles["OPS"] != model.RoleEditor {
+		t.Errorf("expected the group roles to be stored, got %+v", got)
+	}
+
+	// the workspace must keep at least one owner
+	owner := model.SetWorkspaceMemberParams{Role: model.RoleOwner}
+	_, err = m.SetWorkspaceMember(ctx, ws.Id, alice.Id, viewer)
+	assertErrorType[model.ErrBadRequest](t, err)
+	_, err = m.RemoveWorkspaceMember(ctx, ws.Id, alice.Id)
+	assertErrorType[model.ErrBadRequest](t, err)
+	must(m.SetWorkspaceMember(ctx, ws.Id, alice.Id, owner))
+	must(m.SetWorkspaceMember(ctx, ws.Id, bob.Id, owner))
+	must(m.SetWorkspaceMember(ctx, ws.Id, alice.Id, viewer))
+	_, err = m.RemoveWorkspaceMember(ctx, ws.Id, bob.Id)
+	assertErrorType[model.ErrBadRequest](t, err)
+	must(m.SetWorkspaceMember(ctx, ws.Id, alice.Id, owner))
+
+	visible := func(userId string) []string {
+		out := make([]string, 0)
+		for _, item := range must(m.ListWorkspaces(ctx, model.ListWorkspacesParams{VisibleTo: &userId, PageSize: ref.Ref(100)})).Items {
+			out = append(out, item.Id)
+		}
+		return out
+	}
+	expected := []string{ws.Id, model.SharedWorkspaceId}
+	slices.Sort(expected)
+	if got := fmt.Sprint(visible(bob.Id)); got != fmt.Sprint(expected) {
+		t.Errorf("unexpected visible workspaces %s", got)
+	}
+	if _, err := m.RemoveWorkspaceMember(ctx, ws.Id, bob.Id); err != nil {
+		t.Fatal(err)
+	}
+	_, err = m.RemoveWorkspaceMember(ctx, ws.Id, bob.Id)
+	assertErrorType[model.ErrNotFound](t, err)
+	if got := fmt.Sprint(visible(bob.Id)); got != fmt.Sprint([]string{model.SharedWorkspaceId}) {
+		t.Errorf("unexpected visible workspaces after removal %s", got)
+	}
+
+	if err := m.DeleteWorkspace(ctx, ws.Id); err != nil {
+		t.Fatal(err)
+	}
+	_, err = m.ListWorkspaceMembers(ctx, ws.Id)
+	assertErrorType[model.ErrNotFound](t, err)
+}
+
+func testTodoAssignees(t *testing.T, m model.Modelling) {
+	ctx := context.Background()
+	alice := must(m.CreateUser(ctx, model.CreateUsersParams{DisplayName: "Alice"}))
+	bob := must(m.CreateUser(ctx, model.CreateUsersParams{DisplayName: "Bob"}))
+	carol := must(m.CreateUser(ctx, model.CreateUsersParams{DisplayName: "Carol"}))
+	ws := must(m.CreateWorkspace(ctx, model.CreateWorkspacesParams{DisplayName: t.Name(), MemberId: &alice.Id})).Id
+	must(m.SetWorkspaceMember(ctx, ws, bob.Id, model.SetWorkspaceMemberParams{Role: model.RoleEditor}))
+
+	created := must(m.CreateTodo(ctx, ws, model.CreateTodosParams{GroupId: "A", Title: "Assigned", Assignee: &alice.Id, Watchers: []string{bob.Id, alice.Id, bob.Id}}))
+	expectedWatchers := []string{alice.Id, bob.Id}
+	slices.Sort(expectedWatchers)
+	if ref.DeRefOr(created.Assignee, "") != alice.Id || !slices.Equal(created.Watchers, expectedWatchers) {
+		t.Errorf("unexpected assignee and watchers %+v", created)
+	}
+	must(m.CreateTodo(ctx, ws, model.CreateTodosParams{GroupId: "A", Title: "Unassigned"}))
+	_, err := m.CreateTodo(ctx, ws, model.CreateTodosParams{GroupId: "A", Title: "Outsider", Assignee: &carol.Id})
+	assertErrorType[model.ErrBadRequest](t, err)
+	_, err = m.CreateTodo(ctx, ws, model.CreateTodosParams{GroupId: "A", Title: "Outsider", Watchers: []string{carol.Id}})
+	assertErrorType[model.ErrBadRequest](t, err)
+	_, err = m.UpdateTodo(ctx, ws, "A-1", model.UpdateTodosParams{Revision: 0, Assignee: &carol.Id})
+	assertErrorType[model.ErrBadRequest](t, err)
+
+	// only the given fields are replaced, an empty assignee unassigns the todo
+	updated := must(m.UpdateTodo(ctx, ws, "A-1", model.UpdateTodosParams{Revision: 0, Assignee: &bob.Id}))
+	if ref.DeRefOr(updated.Assignee, "") != bob.Id || !slices.Equal(updated.Watchers, expectedWatchers) {
+		t.Errorf("unexpected todo after reassigning %+v", updated)
+	}
+	updated = must(m.UpdateTodo(ctx, ws, "A-2", model.UpdateTodosParams{Revision: 0, Watchers: &[]string{bob.Id}}))
+	if updated.Assignee != nil || !slices.Equal(updated.Watchers, []string{bob.Id}) {
+		t.Errorf("unexpected todo after watching %+v", updated)
+	}
+
+	list := func(assignee string) string {
+		ids := make([]string, 0)
+		for _, item := range must(m.ListTodos(ctx, ws, model.ListTodosParams{TodoFilter: model.TodoFilter{ByAssignee: &assignee}})).Items {
+			ids = append(ids, fmt.Sprintf("%s-%d", item.Group.Id, item.Id))
+		}
+		return fmt.Sprint(ids)
+	}
+	if got := list(bob.Id); got != "[A-1]" {
+		t.Errorf("expected the todos assigned to bob, got %s", got)
+	}
+	if got := list(""); got != "[A-2]" {
+		t.Errorf("expected the unassigned todos, got %s", got)
+	}
+	if got := list(alice.Id); got != "[]" {
+		t.Errorf("expected no todos assigned to alice, got %s", got)
+	}
+	must(m.UpdateTodo(ctx, ws, "A-1", model.UpdateTodosParams{Revision: 1, Assignee: ref.Ref(""), Watchers: &[]string{}}))
+	if got := list(""); got != "[A-1 A-2]" {
+		t.Errorf("expected both todos to be unassigned, got %s", got)
+	}
+	if got := must(m.GetTodo(ctx, ws, "A-1")); got.Assignee != nil || len(got.Watchers) != 0 {
+		t.Errorf("expected the assignee and watchers to be cleared, got %+v", got)
+	}
+	history := must(m.ListTodoHistory(ctx, ws, "A-1"))
+	if changes := history[len(history)-1].Changes; len(changes) != 2 || changes[0].Field != "assignee" || ref.DeRefOr(changes[0].From, "") != bob.Id || changes[0].To != nil || changes[1].Field != "watchers" {
+		t.Errorf("unexpected changes %+v", changes)
+	}
+
+	// a removed member is cleared from the todos of the workspace, including those in the trash
+	must(m.CreateTodo(ctx, ws, model.CreateTodosParams{GroupId: "A", Title: "Trashed", Assignee: &bob.Id, Watchers: []string{alice.Id, bob.Id}}))
+	must(m.DeleteTodo(ctx, ws, "A-3", model.DeleteTodosParams{}))
+	removed := must(m.RemoveWorkspaceMember(ctx, ws, bob.Id))
+	if len(removed) != 2 || removed[0].Id != 2 || removed[1].Id != 3 {
+		t.Fatalf("expected A-2 and A-3 to change, got %+v", removed)
+	}
+	if got := must(m.GetTodo(ctx, ws, "A-2")); got.Revision != 2 || got.Assignee != nil || len(got.Watchers) != 0 {
+		t.Errorf("expected bob to stop watching A-2, got %+v", got)
+	}
+	if got := removed[1]; got.Revision != 2 || got.Assignee != nil || !slices.Equal(got.Watchers, []string{alice.Id}) || got.DeletedAt == nil {
+		t.Errorf("expected bob to be cleared from the trashed A-3, got %+v", got)
+	}
+	history = must(m.ListTodoHistory(ctx, ws, "A-2"))
+	if last := history[len(history)-1]; last.Revision != 2 || last.Action != model.RevisionActionUpdated || len(last.Changes) != 1 || last.Changes[0].Field != "watchers" {
+		t.Errorf("unexpected revision after removing bob %+v", last)
+	}
+	if got := must(m.GetTodo(ctx, ws, "A-1")); got.Revision != 2 {
+		t.Errorf("expected A-1 to be untouched, got %+v", got)
+	}
+
+	// anyone can be assigned in the public workspace since it has no members
+	public := must(m.CreateTodo(ctx, model.SharedWorkspaceId, model.CreateTodosParams{GroupId: "TODO", Title: t.Name(), Assignee: &carol.Id}))
+	if ref.DeRefOr(public.Assignee, "") != carol.Id {
+		t.Errorf("unexpected public todo %+v", public)
+	}
+	_, err = m.CreateTodo(ctx, model.SharedWorkspaceId, model.CreateTodosParams{GroupId: "TODO", Title: t.Name(), Assignee: ref.Ref(model.NewUserId())})
+	assertErrorType[model.ErrBadRequest](t, err)
+}
+
+func testServiceAccounts(t *testing.T, m model.Modelling) {
+	ctx := context.Background()
+	ws := newWorkspace(t, m)
+	_, err := m.CreateServiceAccount(ctx, model.SharedWorkspaceId, model.CreateServiceAccountsParams{DisplayName: "ci"})
+	assertErrorType[model.ErrBadRequest](t, err)
+	account := must(m.CreateServiceAccount(ctx, ws, model.CreateServiceAccountsParams{DisplayName: "ci"}))
+	if accounts := must(m.ListServiceAccounts(ctx, ws)); len(accounts) != 1 || accounts[0].Id != account.Id || accounts[0].DisplayName != "ci" {
+		t.Errorf("unexpected service accounts %+v", accounts)
+	}
+
+	_, err = m.CreateServiceAccountToken(ctx, ws, account.Id, model.CreateServiceAccountTokensParams{Name: "none"})
+	assertErrorType[model.ErrBadRequest](t, err)
+	_, err = m.CreateServiceAccountToken(ctx, ws, account.Id, model.CreateServiceAccountTokensParams{Name: "admin", Scopes: []string{"everything"}})
+	assertErrorType[model.ErrBadRequest](t, err)
+	_, err = m.CreateServiceAccountToken(ctx, ws, model.NewWorkspaceId(), model.CreateServiceAccountTokensParams{Name: "missing", Scopes: []string{model.ScopeTodosRead}})
+	assertErrorType[model.ErrNotFound](t, err)
+
+	token := must(m.CreateServiceAccountToken(ctx, ws, account.Id, model.CreateServiceAccountTokensParams{
+		Name: "deploy", Scopes: []string{model.ScopeTodosRead, model.ScopeTodosWrite}, GroupIds: []string{"OPS"},
+	}))
+	if !model.IsServiceToken(token.Secret) || token.WorkspaceId != ws || !token.ExpiresAt.After(time.Now().Add(model.DefaultServiceTokenTTL-time.Hour)) {
+		t.Fatalf("unexpected token %+v", token)
+	}
+	_, err = m.UseServiceAccountToken(ctx, token.Secret+"x", time.Now())
+	assertErrorType[model.ErrNotFound](t, err)
+	_, err = m.UseServiceAccountToken(ctx, token.Secret, token.ExpiresAt.Add(time.Second))
+	assertErrorType[model.ErrNotFound](t, err)
+	used := must(m.UseServiceAccountToken(ctx, token.Secret, time.Now()))
+	if used.Id != token.Id || used.LastUsedAt == nil || fmt.Sprint(used.Scopes) != fmt.Sprint(token.Scopes) || fmt.Sprint(used.GroupIds) != "[OPS]" {
+		t.Errorf("unexpected used token %+v", used)
+	}
+	if tokens := must(m.ListServiceAccountTokens(ctx, ws, account.Id)); len(tokens) != 1 || tokens[0].Secret != "" || tokens[0].LastUsedAt == nil {
+		t.Errorf("unexpected tokens %+v", tokens)
+	}
+
+	if err := m.RevokeServiceAccountToken(ctx, ws, account.Id, token.Id); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.RevokeServiceAccountToken(ctx, ws, account.Id, token.Id); err != nil {
+		t.Errorf("expected revoking twice to succeed, got %v", err)
+	}
+	assertErrorType[model.ErrNotFound](t, m.RevokeServiceAccountToken(ctx, ws, account.Id, model.NewWorkspaceId()))
+	_, err = m.UseServiceAccountToken(ctx, token.Secret, time.Now())
+	assertErrorType[model.ErrNotFound](t, err)
+	if tokens := must(m.ListServiceAccountTokens(ctx, ws, account.Id)); len(tokens) != 1 || tokens[0].RevokedAt == nil {
+		t.Errorf("expected the revoked token to be listed, got %+v", tokens)
+	}
+
+	other := must(m.CreateServiceAccountToken(ctx, ws, account.Id, model.CreateServiceAccountTokensParams{Name: "other", Scopes: []string{model.ScopeTodosRead}}))
+	if err := m.DeleteServiceAccount(ctx, ws, account.Id); err != nil {
+		t.Fatal(err)
+	}
+	_, err = m.UseServiceAccountToken(ctx, other.Secret, time.Now())
+	assertErrorType[model.ErrNotFound](t, err)
+	_, err = m.ListServiceAccountTokens(ctx, ws, account.Id)
+	assertErrorType[model.ErrNotFound](t, err)
+}
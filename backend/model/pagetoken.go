@@ -0,0 +1,121 @@
+package model
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/astromechza/todo-app/pkg/ref"
+)
+
+// DefaultPageTokenTTL is how long a page token remains valid after it was issued.
+const DefaultPageTokenTTL = 24 * time.Hour
+
+// PageTokenSigner signs and verifies the page tokens of the list operations with a shared key. Tokens signed with a
+// random key cannot be verified by other replicas or after a restart.
+type PageTokenSigner struct {
+	key []byte
+	ttl time.Duration
+}
+
+func NewPageTokenSigner(key []byte, ttl time.Duration) *PageTokenSigner {
+	return &PageTokenSigner{key: key, ttl: ttl}
+}
+
+// RandomPageTokenKey generates a new key for signing page tokens.
+func RandomPageTokenKey() []byte {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		panic(fmt.Errorf("failed to generate page token key: %w", err))
+	}
+	return key
+}
+
+// pageTokenPayload is the signed content of a page token. The scope names the list that issued the token, like the
+// todos of a particular workspace, so that a token cannot be used to page through a different list.
+type pageTokenPayload struct {
+	Scope   string          `json:"s"`
+	Expires int64           `json:"e"`
+	Value   json.RawMessage `json:"v"`
+}
+
+func (s *PageTokenSigner) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// WorkspacesPageScope is the scope of the page tokens of ListWorkspaces.
+const WorkspacesPageScope = "workspaces"
+
+// TodosPageScope returns the scope of the page tokens of ListTodos in the workspace.
+func TodosPageScope(workspaceId string) string {
+	return "todos/" + workspaceId
+}
+
+// Decode verifies a page token issued for the scope and decodes its value. A nil token leaves the value untouched.
+func (s *PageTokenSigner) Decode(token *string, scope string, into interface{}) error {
+	if token == nil {
+		return nil
+	}
+	rawPayload, rawSignature, ok := strings.Cut(*token, ".")
+	if !ok {
+		return ErrBadRequest("failed to decode page token")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(rawPayload)
+	if err != nil {
+		return ErrBadRequest("failed to decode page token")
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(rawSignature)
+	if err != nil {
+		return ErrBadRequest("failed to decode page token")
+	}
+	if !hmac.Equal(signature, s.sign(payload)) {
+		return ErrBadRequest("the page token signature is invalid")
+	}
+	var decoded pageTokenPayload
+	if err := json.Unmarshal(payload, &decoded); err != nil {
+		return ErrBadRequest("failed to unmarshal page token")
+	}
+	if decoded.Scope != scope {
+		return ErrBadRequest("the page token was issued for a different list")
+	}
+	if time.Now().Unix() > decoded.Expires {
+		return ErrBadRequest("the page token has expired, list from the first page again")
+	}
+	if err := json.Unmarshal(decoded.Value, into); err != nil {
+		return ErrBadRequest("failed to unmarshal page token")
+	}
+	return nil
+}
+
+// Encode encodes the value as a signed page token for the scope.
+func (s *PageTokenSigner) Encode(scope string, value interface{}) (*string, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal page token: %w", err)
+	}
+	payload, err := json.Marshal(pageTokenPayload{Scope: scope, Expires: time.Now().Add(s.ttl).Unix(), Value: raw})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal page token: %w", err)
+	}
+	return ref.Ref(base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(s.sign(payload))), nil
+}
+
+const DefaultPageSize = 20
+
+// PageLimit returns the validated page size or the default page size if none was requested.
+func PageLimit(pageSize *int) (int, error) {
+	if pageSize == nil {
+		return DefaultPageSize, nil
+	}
+	if *pageSize < 1 || *pageSize > 1000 {
+		return 0, ErrBadRequest("page size out of range [1,1000]")
+	}
+	return *pageSize, nil
+}
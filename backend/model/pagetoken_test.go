@@ -0,0 +1,32 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPageTokenExpiryAndKey(t *testing.T) {
+	value := map[string]int{"i": 1}
+
+	token, err := NewPageTokenSigner([]byte("key"), -time.Second).Encode("scope", value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := NewPageTokenSigner([]byte("key"), time.Minute).Decode(token, "scope", &value); err == nil || err.Error() != "the page token has expired, list from the first page again" {
+		t.Fatalf("expected an expired token, got %v", err)
+	}
+
+	signer := NewPageTokenSigner([]byte("key"), time.Minute)
+	if token, err = signer.Encode("scope", value); err != nil {
+		t.Fatal(err)
+	}
+	if err := signer.Decode(token, "scope", &value); err != nil {
+		t.Fatalf("expected a valid token, got %v", err)
+	}
+	if err := signer.Decode(token, "other scope", &value); err == nil || err.Error() != "the page token was issued for a different list" {
+		t.Fatalf("expected a different list, got %v", err)
+	}
+	if err := NewPageTokenSigner([]byte("other key"), time.Minute).Decode(token, "scope", &value); err == nil || err.Error() != "the page token signature is invalid" {
+		t.Fatalf("expected an invalid signature, got %v", err)
+	}
+}
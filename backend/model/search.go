@@ -0,0 +1,37 @@
+package model
+
+import (
+	"strings"
+)
+
+// SearchTerms splits a search query into the lower case terms that must all match a todo.
+func SearchTerms(query *string) []string {
+	if query == nil {
+		return nil
+	}
+	return strings.Fields(strings.ToLower(*query))
+}
+
+// SearchScore is the simple relevance score used by backends without full text search. Every term must appear in the
+// title or details of the todo, a term in the title scores 2 and a term in the details scores 1. A score of 0 means
+// the todo does not match.
+func SearchScore(todo *Todo, terms []string) float64 {
+	title, details := strings.ToLower(todo.Title), ""
+	if todo.Details != nil {
+		details = strings.ToLower(*todo.Details)
+	}
+	var score float64
+	for _, term := range terms {
+		inTitle, inDetails := strings.Contains(title, term), strings.Contains(details, term)
+		if !inTitle && !inDetails {
+			return 0
+		}
+		if inTitle {
+			score += 2
+		}
+		if inDetails {
+			score += 1
+		}
+	}
+	return score
+}
@@ -0,0 +1,138 @@
+package model
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ServiceTokenPrefix starts every service account token secret so that they can be told apart from the api keys of
+// users and from bearer tokens.
+const ServiceTokenPrefix = "tds_"
+
+// DefaultServiceTokenTTL is how long a service account token remains valid when no expiry is given.
+const DefaultServiceTokenTTL = 90 * 24 * time.Hour
+
+// The scopes that an operation may require. A member is granted the scopes of their role, see ScopeRoles, while a
+// service account token is granted the scopes it was created with.
+const (
+	ScopeTodosRead      = "todos:read"
+	ScopeTodosComment   = "todos:comment"
+	ScopeTodosWrite     = "todos:write"
+	ScopeWorkspaceAdmin = "workspace:admin"
+)
+
+// ScopeRoles maps each scope to the least privileged member role that is granted it.
+var ScopeRoles = map[string]string{
+	ScopeTodosRead:      RoleViewer,
+	ScopeTodosComment:   RoleCommenter,
+	ScopeTodosWrite:     RoleEditor,
+	ScopeWorkspaceAdmin: RoleOwner,
+}
+
+// ServiceAccount is a non-human identity owned by a workspace, for automation such as CI jobs and bots. It can only
+// access its own workspace, using the tokens issued to it.
+type ServiceAccount struct {
+	Id          string
+	WorkspaceId string
+	DisplayName string
+	CreatedAt   time.Time
+}
+
+type CreateServiceAccountsParams struct {
+	DisplayName string
+}
+
+// ServiceAccountToken is a credential of a service account that grants a fixed set of scopes, optionally limited to
+// some groups of the workspace. Only the hash of the secret is stored, the Secret is only set on the token returned
+// when it is created.
+type ServiceAccountToken struct {
+	Id               string
+	ServiceAccountId string
+	WorkspaceId      string
+	Name             string
+	Scopes           []string
+	// GroupIds limits the token to requests that only affect these groups, when it is not empty.
+	GroupIds   []string
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	Secret     string
+}
+
+// Active reports whether the token may still be used at the given time.
+func (t *ServiceAccountToken) Active(at time.Time) bool {
+	return t.RevokedAt == nil && at.Before(t.ExpiresAt)
+}
+
+// AllowsGroups reports whether the token may be used for a request that affects the groups. A nil set of groups is a
+// request that may affect any group of the workspace.
+func (t *ServiceAccountToken) AllowsGroups(groupIds []string) bool {
+	if len(t.GroupIds) == 0 {
+		return true
+	} else if groupIds == nil {
+		return false
+	}
+	for _, groupId := range groupIds {
+		if !slices.Contains(t.GroupIds, groupId) {
+			return false
+		}
+	}
+	return true
+}
+
+type CreateServiceAccountTokensParams struct {
+	Name     string
+	Scopes   []string
+	GroupIds []string
+	// ExpiresAt defaults to DefaultServiceTokenTTL from now.
+	ExpiresAt *time.Time
+}
+
+func (p *CreateServiceAccountTokensParams) Validate() error {
+	if len(p.Scopes) == 0 {
+		return ErrBadRequest("a service account token requires at least one scope")
+	}
+	for _, scope := range p.Scopes {
+		if _, ok := ScopeRoles[scope]; !ok {
+			return ErrBadRequest(fmt.Sprintf("unknown scope '%s'", scope))
+		}
+	}
+	if p.ExpiresAt != nil && !p.ExpiresAt.After(time.Now()) {
+		return ErrBadRequest("the expiry of a service account token must be in the future")
+	}
+	return nil
+}
+
+// NewServiceAccountToken generates the id and secret of a new token for the service account.
+func NewServiceAccountToken(account *ServiceAccount, params CreateServiceAccountTokensParams) ServiceAccountToken {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		panic(err)
+	}
+	now := time.Now().UTC()
+	out := ServiceAccountToken{
+		Id:               NewWorkspaceId(),
+		ServiceAccountId: account.Id,
+		WorkspaceId:      account.WorkspaceId,
+		Name:             params.Name,
+		Scopes:           slices.Clone(params.Scopes),
+		GroupIds:         slices.Clone(params.GroupIds),
+		CreatedAt:        now,
+		ExpiresAt:        now.Add(DefaultServiceTokenTTL),
+		Secret:           ServiceTokenPrefix + base64.RawURLEncoding.EncodeToString(raw),
+	}
+	if params.ExpiresAt != nil {
+		out.ExpiresAt = params.ExpiresAt.UTC()
+	}
+	return out
+}
+
+// IsServiceToken reports whether the credential looks like a service account token secret.
+func IsServiceToken(credential string) bool {
+	return strings.HasPrefix(credential, ServiceTokenPrefix)
+}
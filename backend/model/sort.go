@@ -0,0 +1,80 @@
+package model
+
+import (
+	"strings"
+	"time"
+)
+
+type TodoSortField string
+
+const (
+	// TodoSortDefault orders the todos by relevance when searching, and then by group and id.
+	TodoSortDefault   TodoSortField = ""
+	TodoSortCreatedAt TodoSortField = "created_at"
+	TodoSortUpdatedAt TodoSortField = "updated_at"
+	// TodoSortTitle orders the todos by their lower-cased title, compared byte by byte so that every model agrees on
+	// the order regardless of the collation of its database.
+	TodoSortTitle TodoSortField = "title"
+)
+
+// TodoSort is the order of the todos returned by ListTodos. Todos with the same sort key are ordered by group and id
+// so that the order is total and pages can be continued from the key of their last todo. Like the filter, the sort
+// of the first page is recorded in its page token.
+type TodoSort struct {
+	Field      TodoSortField `json:"f,omitempty"`
+	Descending bool          `json:"d,omitempty"`
+}
+
+// sortKeyTimeLayout is a fixed width layout so that the keys of times sort in the same order as the times.
+const sortKeyTimeLayout = "2006-01-02T15:04:05.000000000Z"
+
+// Key returns the sort key of the todo as a string that orders in the same way as the sort field.
+func (s *TodoSort) Key(todo *Todo) string {
+	switch s.Field {
+	case TodoSortCreatedAt:
+		return todo.EpochAt.UTC().Format(sortKeyTimeLayout)
+	case TodoSortUpdatedAt:
+		return todo.RevisionAt.UTC().Format(sortKeyTimeLayout)
+	case TodoSortTitle:
+		return strings.ToLower(todo.Title)
+	default:
+		return ""
+	}
+}
+
+// ParseKey converts a key returned by Key back into the value of the sort field.
+func (s *TodoSort) ParseKey(key string) (interface{}, error) {
+	switch s.Field {
+	case TodoSortCreatedAt, TodoSortUpdatedAt:
+		t, err := time.Parse(sortKeyTimeLayout, key)
+		if err != nil {
+			return nil, ErrBadRequest("failed to parse the sort key of the page token")
+		}
+		return t, nil
+	default:
+		return key, nil
+	}
+}
+
+// Resolve validates the sort and reconciles it with the sort recorded in the page token of the previous page, if
+// any, in the same way as TodoFilter.Resolve.
+func (s *TodoSort) Resolve(recorded *TodoSort) error {
+	switch s.Field {
+	case TodoSortDefault:
+		if s.Descending {
+			return ErrBadRequest("a descending order requires a sort field")
+		}
+	case TodoSortCreatedAt, TodoSortUpdatedAt, TodoSortTitle:
+	default:
+		return ErrBadRequest("unknown sort field '" + string(s.Field) + "'")
+	}
+	if recorded == nil {
+		return nil
+	}
+	if *s == (TodoSort{}) {
+		*s = *recorded
+	} else if *s != *recorded {
+		return ErrBadRequest("the sort does not match the sort of the page token")
+	}
+	return nil
+}
@@ -0,0 +1,249 @@
+package sqlmodel
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	"modernc.org/sqlite"
+
+	"github.com/astromechza/todo-app/backend/model"
+)
+
+// dialect describes how to talk to one kind of database. Queries in this package are written with ? placeholders and
+// portable sql, anything that cannot be expressed portably lives here.
+type dialect struct {
+	// driver is the database/sql driver name.
+	driver string
+	// gooseDialect is the dialect name understood by goose.
+	gooseDialect string
+	// migrations is the directory of embedded migrations for this dialect.
+	migrations string
+	// numberedPlaceholders is true when the driver expects $1, $2, .. rather than ?.
+	numberedPlaceholders bool
+	// insertIgnoreSuffix ends an INSERT statement so that it does nothing when the key already exists, see
+	// insertIfAbsent.
+	insertIgnoreSuffix string
+	// isDuplicateKey reports whether the error of an INSERT is a duplicate key, for dialects that cannot ignore only
+	// the duplicates with a suffix.
+	isDuplicateKey func(err error) bool
+	// dsn converts the connection string into the data source name expected by the driver.
+	dsn func(connString string) string
+	// singleConnection is true when the database cannot be shared between connections, like an in-memory sqlite.
+	singleConnection bool
+	// titleOrder is the expression that todos are sorted by when sorting by title. It must order in the same way as
+	// model.TodoSort.Key, which compares the lower-cased titles byte by byte.
+	titleOrder string
+	// search returns the condition that matches todos for the search query and the expression that ranks them, higher
+	// ranks are more relevant.
+	search func(query string) (match sqlExpr, rank sqlExpr)
+}
+
+// sqlExpr is a fragment of sql along with the arguments for its placeholders.
+type sqlExpr struct {
+	sql  string
+	args []interface{}
+}
+
+var dialects = map[string]*dialect{
+	"postgres": {
+		driver:               "postgres",
+		gooseDialect:         "postgres",
+		migrations:           "migrations/postgres",
+		numberedPlaceholders: true,
+		insertIgnoreSuffix:   " ON CONFLICT DO NOTHING",
+		dsn: func(connString string) string {
+			return connString
+		},
+		titleOrder: `LOWER(title) COLLATE "C"`,
+		// the search column is a weighted tsvector of the title and details, see the migrations
+		search: func(query string) (sqlExpr, sqlExpr) {
+			return sqlExpr{`search @@ websearch_to_tsquery('english', ?)`, []interface{}{query}},
+				sqlExpr{`ts_rank(search, websearch_to_tsquery('english', ?))`, []interface{}{query}}
+		},
+	},
+	"mysql": {
+		driver:       "mysql",
+		gooseDialect: "mysql",
+		migrations:   "migrations/mysql",
+		// INSERT IGNORE would also ignore foreign key violations and truncation, and ON DUPLICATE KEY UPDATE reports the
+		// duplicates as affected rows because of clientFoundRows, so only the duplicate key error is ignored
+		isDuplicateKey: func(err error) bool {
+			var mysqlErr *mysql.MySQLError
+			return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlDuplicateKey
+		},
+		dsn: func(connString string) string {
+			dsn := strings.TrimPrefix(connString, "mysql://")
+			if strings.Contains(dsn, "?") {
+				dsn += "&"
+			} else {
+				dsn += "?"
+			}
+			// timestamps must be scanned into time.Time rather than []byte, and updates must report the rows they
+			// matched rather than the rows they changed so that an unchanged row is not mistaken for a missing one
+			return dsn + "parseTime=true&clientFoundRows=true"
+		},
+		// the title column is case-insensitive, the binary collation without padding compares the bytes as they are
+		titleOrder: `LOWER(title) COLLATE utf8mb4_0900_bin`,
+		search:     likeSearch,
+	},
+	"sqlite": {
+		driver:             "sqlite",
+		gooseDialect:       "sqlite3",
+		migrations:         "migrations/sqlite",
+		insertIgnoreSuffix: " ON CONFLICT DO NOTHING",
+		dsn: func(connString string) string {
+			dsn := strings.TrimPrefix(connString, "sqlite://")
+			// foreign keys are disabled by default in sqlite, and the cascading deletes rely on them
+			if strings.Contains(dsn, "?") {
+				dsn += "&"
+			} else {
+				dsn += "?"
+			}
+			return dsn + "_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)"
+		},
+		// a single connection avoids busy errors between writers and keeps :memory: databases alive
+		singleConnection: true,
+		// the built-in LOWER only lower-cases ascii letters, see init
+		titleOrder: `unicode_lower(title)`,
+		search:     likeSearch,
+	},
+}
+
+func init() {
+	dialects["postgresql"] = dialects["postgres"]
+	sqlite.MustRegisterDeterministicScalarFunction("unicode_lower", 1, func(ctx *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+		switch v := args[0].(type) {
+		case string:
+			return strings.ToLower(v), nil
+		case []byte:
+			return strings.ToLower(string(v)), nil
+		default:
+			return v, nil
+		}
+	})
+}
+
+// rebind rewrites the ? placeholders in the query into the form expected by the driver.
+func (d *dialect) rebind(query string) string {
+	if !d.numberedPlaceholders {
+		return query
+	}
+	var sb strings.Builder
+	sb.Grow(len(query) + 10)
+	n := 0
+	for _, c := range query {
+		if c == '?' {
+			n++
+			sb.WriteByte('$')
+			sb.WriteString(strconv.Itoa(n))
+		} else {
+			sb.WriteRune(c)
+		}
+	}
+	return sb.String()
+}
+
+// mysqlDuplicateKey is the number of the mysql error ER_DUP_ENTRY.
+const mysqlDuplicateKey = 1062
+
+// insertIfAbsent runs the INSERT statement, ignoring it if a row with the same key already exists, and reports whether
+// the row was inserted. Any other error is returned.
+func insertIfAbsent(ctx context.Context, q queryer, d *dialect, query string, args ...interface{}) (bool, error) {
+	res, err := q.ExecContext(ctx, query+d.insertIgnoreSuffix, args...)
+	if err != nil {
+		if d.isDuplicateKey != nil && d.isDuplicateKey(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	count, _ := res.RowsAffected()
+	return count > 0, nil
+}
+
+// queryer is implemented by both database and transaction so that helpers can run inside or outside a transaction.
+type queryer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// database wraps the connection pool and rebinds every query for the dialect.
+type database struct {
+	*sql.DB
+	dialect *dialect
+}
+
+func (d *database) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return d.DB.ExecContext(ctx, d.dialect.rebind(query), args...)
+}
+
+func (d *database) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return d.DB.QueryContext(ctx, d.dialect.rebind(query), args...)
+}
+
+func (d *database) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return d.DB.QueryRowContext(ctx, d.dialect.rebind(query), args...)
+}
+
+func (d *database) BeginTx(ctx context.Context, opts *sql.TxOptions) (*transaction, error) {
+	tx, err := d.DB.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	return &transaction{Tx: tx, dialect: d.dialect}, nil
+}
+
+// transaction wraps a transaction and rebinds every query for the dialect.
+type transaction struct {
+	*sql.Tx
+	dialect *dialect
+}
+
+func (t *transaction) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return t.Tx.ExecContext(ctx, t.dialect.rebind(query), args...)
+}
+
+func (t *transaction) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return t.Tx.QueryContext(ctx, t.dialect.rebind(query), args...)
+}
+
+func (t *transaction) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return t.Tx.QueryRowContext(ctx, t.dialect.rebind(query), args...)
+}
+
+// inCondition returns a "column IN (?, ..)" condition and its arguments, or an always true condition when there are
+// no values to match.
+func inCondition(column string, values []string) (string, []interface{}) {
+	if len(values) == 0 {
+		return "1 = 1", nil
+	}
+	args := make([]interface{}, len(values))
+	for i, v := range values {
+		args[i] = v
+	}
+	return column + " IN (?" + strings.Repeat(", ?", len(values)-1) + ")", args
+}
+
+// likeSearch is the search for dialects without full text search. It scores the todos in the same way as
+// model.SearchScore.
+func likeSearch(query string) (sqlExpr, sqlExpr) {
+	terms := model.SearchTerms(&query)
+	match, rank := sqlExpr{sql: "1 = 1"}, sqlExpr{sql: "0"}
+	for _, term := range terms {
+		// ! is used as the escape character since backslashes are treated differently by each database
+		pattern := "%" + likeEscaper.Replace(term) + "%"
+		match.sql += ` AND (LOWER(title) LIKE ? ESCAPE '!' OR LOWER(COALESCE(details, '')) LIKE ? ESCAPE '!')`
+		match.args = append(match.args, pattern, pattern)
+		rank.sql += ` + CASE WHEN LOWER(title) LIKE ? ESCAPE '!' THEN 2 ELSE 0 END + CASE WHEN LOWER(COALESCE(details, '')) LIKE ? ESCAPE '!' THEN 1 ELSE 0 END`
+		rank.args = append(rank.args, pattern, pattern)
+	}
+	return match, rank
+}
+
+var likeEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_")
@@ -0,0 +1,173 @@
+package sqlmodel
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/astromechza/todo-app/backend/model"
+	"github.com/astromechza/todo-app/pkg/ref"
+)
+
+func (s *sqlModel) GetGroup(ctx context.Context, workspaceId string, id string) (*model.Group, error) {
+	var out model.Group
+	if err := s.db.QueryRowContext(
+		ctx,
+		`SELECT
+		g.id, g.epoch, g.epoch_at, g.workspace_id, g.workspace_epoch, g.display_name, g.last_serial,
+		(SELECT COUNT(*) FROM todos t WHERE t.workspace_id = g.workspace_id AND t.group_id = g.id AND t.deleted_at IS NULL)
+		FROM todos_groups g WHERE g.workspace_id = ? AND g.id = ?`,
+		workspaceId, id,
+	).Scan(
+		&out.Id, &out.Epoch, &out.EpochAt, &out.Workspace.Id, &out.Workspace.Epoch, &out.DisplayName, &out.LastSerial,
+		&out.TodoCount,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrNotFound(fmt.Sprintf("group '%s' not found", id))
+		}
+		return nil, fmt.Errorf("failed to query and scan group: %w", err)
+	}
+	return &out, nil
+}
+
+func (s *sqlModel) ListGroups(ctx context.Context, workspaceId string) ([]model.Group, error) {
+	if _, err := s.GetWorkspace(ctx, workspaceId); err != nil {
+		return nil, err
+	}
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT
+		g.id, g.epoch, g.epoch_at, g.workspace_id, g.workspace_epoch, g.display_name, g.last_serial,
+		(SELECT COUNT(*) FROM todos t WHERE t.workspace_id = g.workspace_id AND t.group_id = g.id AND t.deleted_at IS NULL)
+		FROM todos_groups g WHERE g.workspace_id = ?
+		ORDER BY g.id`,
+		workspaceId,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query groups: %w", err)
+	}
+	defer rows.Close()
+	outRows := make([]model.Group, 0)
+	for rows.Next() {
+		var out model.Group
+		if err := rows.Scan(
+			&out.Id, &out.Epoch, &out.EpochAt, &out.Workspace.Id, &out.Workspace.Epoch, &out.DisplayName, &out.LastSerial,
+			&out.TodoCount,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan group: %w", err)
+		}
+		outRows = append(outRows, out)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan groups: %w", err)
+	}
+	return outRows, nil
+}
+
+func (s *sqlModel) CreateGroup(ctx context.Context, workspaceId string, params model.CreateGroupsParams) (*model.Group, error) {
+	workspace, err := s.GetWorkspace(ctx, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	out := model.Group{
+		Id:      params.Id,
+		Epoch:   rand.Int63(),
+		EpochAt: time.Now().UTC(),
+		Workspace: model.EntityReference{
+			Id:    workspace.Id,
+			Epoch: workspace.Epoch,
+		},
+		DisplayName: params.DisplayName,
+	}
+	if params.NextSerial != nil {
+		if *params.NextSerial < 1 {
+			return nil, model.ErrBadRequest("next serial must be at least 1")
+		}
+		out.LastSerial = *params.NextSerial - 1
+	}
+
+	if inserted, err := insertIfAbsent(
+		ctx, s.db, s.dialect,
+		`INSERT INTO todos_groups (id, epoch, epoch_at, workspace_id, workspace_epoch, display_name, last_serial) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		out.Id, out.Epoch, out.EpochAt, out.Workspace.Id, out.Workspace.Epoch, ref.DeRefToNullString(out.DisplayName), out.LastSerial,
+	); err != nil {
+		return nil, fmt.Errorf("failed to insert group: %w", err)
+	} else if !inserted {
+		return nil, model.ErrConflict(fmt.Sprintf("group '%s' already exists", out.Id))
+	}
+	return &out, nil
+}
+
+func (s *sqlModel) UpdateGroup(ctx context.Context, workspaceId string, id string, params model.UpdateGroupsParams) (*model.Group, error) {
+	current, err := s.GetGroup(ctx, workspaceId, id)
+	if err != nil {
+		return nil, err
+	}
+	lastSerial := current.LastSerial
+	if params.NextSerial != nil {
+		// serials may only move forward, otherwise we would hand out ids that have been used before
+		if *params.NextSerial <= current.LastSerial {
+			return nil, model.ErrBadRequest(fmt.Sprintf("next serial must be greater than the last used serial %d", current.LastSerial))
+		}
+		lastSerial = *params.NextSerial - 1
+	}
+
+	if res, err := s.db.ExecContext(
+		ctx,
+		`UPDATE todos_groups SET display_name = COALESCE(?, display_name),
+		last_serial = CASE WHEN last_serial > ? THEN last_serial ELSE ? END
+		WHERE workspace_id = ? AND id = ? AND epoch = ?`,
+		ref.DeRefToNullString(params.DisplayName), lastSerial, lastSerial, workspaceId, id, current.Epoch,
+	); err != nil {
+		return nil, fmt.Errorf("failed to update group: %w", err)
+	} else if count, _ := res.RowsAffected(); count == 0 {
+		return nil, model.ErrNotFound(fmt.Sprintf("group '%s' not found", id))
+	}
+	return s.GetGroup(ctx, workspaceId, id)
+}
+
+func (s *sqlModel) DeleteGroup(ctx context.Context, workspaceId string, id string) ([]model.Todo, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(
+		ctx,
+		`SELECT `+todoColumns+` FROM todos WHERE workspace_id = ? AND group_id = ? AND deleted_at IS NULL ORDER BY id`,
+		workspaceId, id,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query todos: %w", err)
+	}
+	defer rows.Close()
+	active := make([]model.Todo, 0)
+	for rows.Next() {
+		todo, err := scanTodo(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan todo: %w", err)
+		}
+		active = append(active, *todo)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan todos: %w", err)
+	}
+	// the rows must be closed before the transaction can run another statement
+	_ = rows.Close()
+
+	// the todos in the group are removed by the cascading foreign key
+	if res, err := tx.ExecContext(ctx, `DELETE FROM todos_groups WHERE workspace_id = ? AND id = ?`, workspaceId, id); err != nil {
+		return nil, fmt.Errorf("failed to delete group: %w", err)
+	} else if count, _ := res.RowsAffected(); count == 0 {
+		return nil, model.ErrNotFound(fmt.Sprintf("group '%s' not found", id))
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit delete: %w", err)
+	}
+	return active, nil
+}
@@ -0,0 +1,93 @@
+package sqlmodel
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/astromechza/todo-app/backend/model"
+)
+
+// storedFieldChange is the json representation of a model.TodoFieldChange in the todo_revisions table.
+type storedFieldChange struct {
+	Field string  `json:"field"`
+	From  *string `json:"from,omitempty"`
+	To    *string `json:"to,omitempty"`
+}
+
+// insertRevision records the current revision of the todo in its history against the actor in the context. It should be called in the same transaction
+// as the change to the todo so that the history can never miss a revision.
+func insertRevision(ctx context.Context, q queryer, todo *model.Todo, action string, changes []model.TodoFieldChange) error {
+	stored := make([]storedFieldChange, len(changes))
+	for i, c := range changes {
+		stored[i] = storedFieldChange{Field: c.Field, From: c.From, To: c.To}
+	}
+	raw, err := json.Marshal(stored)
+	if err != nil {
+		return fmt.Errorf("failed to marshal changes: %w", err)
+	}
+	var actorKind, actorId sql.NullString
+	if actor := model.ActorFrom(ctx); actor != nil {
+		actorKind = sql.NullString{String: actor.Kind, Valid: true}
+		actorId = sql.NullString{String: actor.Id, Valid: true}
+	}
+	if _, err := q.ExecContext(
+		ctx,
+		`INSERT INTO todo_revisions (workspace_id, group_id, todo_id, revision, revision_at, action, changes, actor_kind, actor_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		todo.Workspace.Id, todo.Group.Id, todo.Id, todo.Revision, todo.RevisionAt, action, string(raw), actorKind, actorId,
+	); err != nil {
+		return fmt.Errorf("failed to insert todo revision: %w", err)
+	}
+	return nil
+}
+
+func (s *sqlModel) ListTodoHistory(ctx context.Context, workspaceId string, id string) ([]model.TodoRevision, error) {
+	groupId, todoId := model.SplitGroupId(id)
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT revision, revision_at, action, changes, actor_kind, actor_id FROM todo_revisions
+		WHERE workspace_id = ? AND group_id = ? AND todo_id = ? ORDER BY revision`,
+		workspaceId, groupId, todoId,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query todo revisions: %w", err)
+	}
+	defer rows.Close()
+	outRows := make([]model.TodoRevision, 0)
+	for rows.Next() {
+		var out model.TodoRevision
+		var raw string
+		var actorKind, actorId sql.NullString
+		if err := rows.Scan(&out.Revision, &out.RevisionAt, &out.Action, &raw, &actorKind, &actorId); err != nil {
+			return nil, fmt.Errorf("failed to scan todo revision: %w", err)
+		}
+		if actorKind.Valid {
+			out.Actor = &model.Actor{Kind: actorKind.String, Id: actorId.String}
+		}
+		var stored []storedFieldChange
+		if err := json.Unmarshal([]byte(raw), &stored); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal changes: %w", err)
+		}
+		out.Changes = make([]model.TodoFieldChange, len(stored))
+		for i, c := range stored {
+			out.Changes[i] = model.TodoFieldChange{Field: c.Field, From: c.From, To: c.To}
+		}
+		outRows = append(outRows, out)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan todo revisions: %w", err)
+	}
+	// todos created before the history was introduced have no revisions, so check whether the todo exists at all
+	if len(outRows) == 0 {
+		var count int
+		if err := s.db.QueryRowContext(
+			ctx, `SELECT COUNT(*) FROM todos WHERE workspace_id = ? AND group_id = ? AND id = ?`, workspaceId, groupId, todoId,
+		).Scan(&count); err != nil {
+			return nil, fmt.Errorf("failed to query and scan todo count: %w", err)
+		} else if count == 0 {
+			return nil, model.ErrNotFound("todo not found")
+		}
+	}
+	return outRows, nil
+}
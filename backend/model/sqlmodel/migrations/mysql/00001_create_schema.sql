@@ -0,0 +1,63 @@
+-- +goose Up
+
+-- The mysql schema is equivalent to the postgres migrations 00001 to 00004. Identifiers use a binary collation so that
+-- they compare and sort case-sensitively like they do in the other databases.
+
+CREATE TABLE workspaces (
+    id varchar(32) COLLATE utf8mb4_bin not null,
+    epoch bigint not null,
+    epoch_at datetime(6) not null,
+
+    display_name text not null,
+    -- The json encoded status workflow of the workspace, or null to use the default workflow.
+    workflow text,
+
+    CONSTRAINT workspaces_pk PRIMARY KEY (id)
+);
+
+-- The public workspace always exists and keeps the default epoch.
+INSERT INTO workspaces (id, epoch, epoch_at, display_name) VALUES ('public', 0, UTC_TIMESTAMP(6), 'Public');
+
+CREATE TABLE todos_groups (
+    id varchar(64) COLLATE utf8mb4_bin not null,
+    epoch bigint not null,
+    epoch_at datetime(6) not null,
+
+    workspace_id varchar(32) COLLATE utf8mb4_bin not null,
+    workspace_epoch bigint not null,
+
+    display_name text,
+    last_serial bigint not null,
+
+    CONSTRAINT todos_groups_pk PRIMARY KEY (workspace_id, id),
+    CONSTRAINT todos_groups_workspace_fk FOREIGN KEY (workspace_id) REFERENCES workspaces (id) ON DELETE CASCADE
+);
+
+CREATE TABLE todos (
+    id bigint not null,
+    epoch bigint not null,
+    epoch_at datetime(6) not null,
+
+    revision bigint not null,
+    revision_at datetime(6) not null,
+
+    group_id varchar(64) COLLATE utf8mb4_bin not null,
+    group_epoch bigint not null,
+
+    workspace_id varchar(32) COLLATE utf8mb4_bin not null,
+    workspace_epoch bigint not null,
+
+    title text not null,
+    details text,
+    status varchar(32) COLLATE utf8mb4_bin not null,
+
+    CONSTRAINT todos_pk PRIMARY KEY (workspace_id, group_id, id),
+    CONSTRAINT todos_group_fk FOREIGN KEY (workspace_id, group_id) REFERENCES todos_groups (workspace_id, id) ON DELETE CASCADE
+);
+CREATE INDEX todos_status_idx ON todos (status);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS todos;
+DROP TABLE IF EXISTS todos_groups;
+DROP TABLE IF EXISTS workspaces;
@@ -0,0 +1,23 @@
+-- +goose Up
+
+-- The history of every todo, one row is written in the same transaction as each change to the todo.
+CREATE TABLE todo_revisions (
+    workspace_id varchar(32) COLLATE utf8mb4_bin not null,
+    group_id varchar(64) COLLATE utf8mb4_bin not null,
+    todo_id bigint not null,
+
+    revision bigint not null,
+    revision_at datetime(6) not null,
+
+    -- One of created, updated, deleted, or restored.
+    action varchar(16) not null,
+    -- The json encoded list of fields that changed with their previous and new values.
+    changes text not null,
+
+    CONSTRAINT todo_revisions_pk PRIMARY KEY (workspace_id, group_id, todo_id, revision),
+    CONSTRAINT todo_revisions_todo_fk FOREIGN KEY (workspace_id, group_id, todo_id) REFERENCES todos (workspace_id, group_id, id) ON DELETE CASCADE
+);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS todo_revisions;
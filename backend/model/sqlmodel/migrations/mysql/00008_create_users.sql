@@ -0,0 +1,40 @@
+-- +goose Up
+
+CREATE TABLE users (
+    id varchar(32) COLLATE utf8mb4_bin not null,
+    epoch_at datetime(6) not null,
+    display_name text not null,
+
+    CONSTRAINT users_pk PRIMARY KEY (id)
+);
+
+-- Only the sha256 hash of each api key secret is stored.
+CREATE TABLE api_keys (
+    id varchar(32) COLLATE utf8mb4_bin not null,
+    user_id varchar(32) COLLATE utf8mb4_bin not null,
+    name varchar(200) not null,
+    created_at datetime(6) not null,
+    secret_hash char(64) not null,
+
+    CONSTRAINT api_keys_pk PRIMARY KEY (id),
+    CONSTRAINT api_keys_secret_hash_uq UNIQUE (secret_hash),
+    CONSTRAINT api_keys_user_fk FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+-- The users that may access each workspace, the public workspace has no members since everyone may access it.
+CREATE TABLE workspace_members (
+    workspace_id varchar(32) COLLATE utf8mb4_bin not null,
+    user_id varchar(32) COLLATE utf8mb4_bin not null,
+    added_at datetime(6) not null,
+
+    CONSTRAINT workspace_members_pk PRIMARY KEY (workspace_id, user_id),
+    CONSTRAINT workspace_members_workspace_fk FOREIGN KEY (workspace_id) REFERENCES workspaces (id) ON DELETE CASCADE,
+    CONSTRAINT workspace_members_user_fk FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+CREATE INDEX workspace_members_user_idx ON workspace_members (user_id);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS workspace_members;
+DROP TABLE IF EXISTS api_keys;
+DROP TABLE IF EXISTS users;
@@ -0,0 +1,11 @@
+-- +goose Up
+
+-- Members that existed before roles were introduced had full control of their workspaces.
+ALTER TABLE workspace_members ADD COLUMN role varchar(16) not null default 'owner';
+-- The json encoded roles of the member in individual groups, or null if there are none.
+ALTER TABLE workspace_members ADD COLUMN group_roles text;
+
+-- +goose Down
+
+ALTER TABLE workspace_members DROP COLUMN group_roles;
+ALTER TABLE workspace_members DROP COLUMN role;
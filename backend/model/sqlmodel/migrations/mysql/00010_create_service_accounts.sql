@@ -0,0 +1,39 @@
+-- +goose Up
+
+-- Service accounts are non-human identities that can only access the workspace that owns them.
+CREATE TABLE service_accounts (
+    id varchar(32) COLLATE utf8mb4_bin not null,
+    workspace_id varchar(32) COLLATE utf8mb4_bin not null,
+    display_name text not null,
+    created_at datetime(6) not null,
+
+    CONSTRAINT service_accounts_pk PRIMARY KEY (id),
+    CONSTRAINT service_accounts_workspace_fk FOREIGN KEY (workspace_id) REFERENCES workspaces (id) ON DELETE CASCADE
+);
+CREATE INDEX service_accounts_workspace_idx ON service_accounts (workspace_id);
+
+-- Only the sha256 hash of each token secret is stored. The scopes and group ids are json encoded lists, the group ids
+-- are null when the token is not limited to any groups.
+CREATE TABLE service_account_tokens (
+    id varchar(32) COLLATE utf8mb4_bin not null,
+    service_account_id varchar(32) COLLATE utf8mb4_bin not null,
+    workspace_id varchar(32) COLLATE utf8mb4_bin not null,
+    name varchar(200) not null,
+    scopes text not null,
+    group_ids text,
+    created_at datetime(6) not null,
+    expires_at datetime(6) not null,
+    last_used_at datetime(6),
+    revoked_at datetime(6),
+    secret_hash char(64) not null,
+
+    CONSTRAINT service_account_tokens_pk PRIMARY KEY (id),
+    CONSTRAINT service_account_tokens_secret_hash_uq UNIQUE (secret_hash),
+    CONSTRAINT service_account_tokens_account_fk FOREIGN KEY (service_account_id) REFERENCES service_accounts (id) ON DELETE CASCADE
+);
+CREATE INDEX service_account_tokens_account_idx ON service_account_tokens (service_account_id);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS service_account_tokens;
+DROP TABLE IF EXISTS service_accounts;
@@ -0,0 +1,27 @@
+-- +goose Up
+
+CREATE TABLE workspaces (
+
+    -- The unique identity of this item is made up of:
+    --- the id which is unique right now, but may be duplicated over time
+    id text not null,
+    --- the version which is a unique nonce for this lifecycle of the id
+    epoch bigint not null,
+    --- the timestamp at which the version was assigned (== the created-at time)
+    epoch_at timestamp with time zone not null,
+
+    display_name text not null,
+
+    CONSTRAINT workspaces_pk PRIMARY KEY (id)
+
+);
+
+-- The public workspace always exists and keeps the default epoch.
+INSERT INTO workspaces (id, epoch, epoch_at, display_name) VALUES ('public', 0, now(), 'Public');
+
+ALTER TABLE todos_groups ADD CONSTRAINT todos_groups_workspace_fk FOREIGN KEY (workspace_id) REFERENCES workspaces (id) ON DELETE CASCADE;
+
+-- +goose Down
+
+ALTER TABLE todos_groups DROP CONSTRAINT IF EXISTS todos_groups_workspace_fk;
+DROP TABLE IF EXISTS workspaces;
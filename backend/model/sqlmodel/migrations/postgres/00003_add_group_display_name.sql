@@ -0,0 +1,7 @@
+-- +goose Up
+
+ALTER TABLE todos_groups ADD COLUMN display_name text;
+
+-- +goose Down
+
+ALTER TABLE todos_groups DROP COLUMN IF EXISTS display_name;
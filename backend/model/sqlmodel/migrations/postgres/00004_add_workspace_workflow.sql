@@ -0,0 +1,8 @@
+-- +goose Up
+
+-- The json encoded status workflow of the workspace, or null to use the default workflow.
+ALTER TABLE workspaces ADD COLUMN workflow text;
+
+-- +goose Down
+
+ALTER TABLE workspaces DROP COLUMN IF EXISTS workflow;
@@ -0,0 +1,13 @@
+-- +goose Up
+
+-- The weighted full text search vector of the todo, matches in the title rank above matches in the details. Other
+-- databases fall back to LIKE matching and need no migration.
+ALTER TABLE todos ADD COLUMN search tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', title), 'A') || setweight(to_tsvector('english', coalesce(details, '')), 'B')
+) STORED;
+CREATE INDEX todos_search_idx ON todos USING GIN (search);
+
+-- +goose Down
+
+DROP INDEX IF EXISTS todos_search_idx;
+ALTER TABLE todos DROP COLUMN IF EXISTS search;
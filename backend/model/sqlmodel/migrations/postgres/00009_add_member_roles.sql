@@ -0,0 +1,11 @@
+-- +goose Up
+
+-- Members that existed before roles were introduced had full control of their workspaces.
+ALTER TABLE workspace_members ADD COLUMN role text not null default 'owner';
+-- The json encoded roles of the member in individual groups, or null if there are none.
+ALTER TABLE workspace_members ADD COLUMN group_roles text;
+
+-- +goose Down
+
+ALTER TABLE workspace_members DROP COLUMN IF EXISTS group_roles;
+ALTER TABLE workspace_members DROP COLUMN IF EXISTS role;
@@ -0,0 +1,16 @@
+-- +goose Up
+
+-- The OpenID Connect identities that users sign in with, each identity is linked to a single user.
+CREATE TABLE user_identities (
+    issuer text not null,
+    subject text not null,
+    user_id text not null,
+
+    CONSTRAINT user_identities_pk PRIMARY KEY (issuer, subject),
+    CONSTRAINT user_identities_user_fk FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+CREATE INDEX user_identities_user_idx ON user_identities (user_id);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS user_identities;
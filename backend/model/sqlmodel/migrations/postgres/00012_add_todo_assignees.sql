@@ -0,0 +1,13 @@
+-- +goose Up
+
+-- The id of the user that the todo is assigned to, or null if the todo is unassigned.
+ALTER TABLE todos ADD COLUMN assignee_id text;
+-- The json encoded ids of the users watching the todo, or null if there are none.
+ALTER TABLE todos ADD COLUMN watchers text;
+CREATE INDEX todos_assignee_idx ON todos (workspace_id, assignee_id);
+
+-- +goose Down
+
+DROP INDEX IF EXISTS todos_assignee_idx;
+ALTER TABLE todos DROP COLUMN IF EXISTS watchers;
+ALTER TABLE todos DROP COLUMN IF EXISTS assignee_id;
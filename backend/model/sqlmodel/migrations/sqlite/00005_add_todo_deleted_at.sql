@@ -0,0 +1,10 @@
+-- +goose Up
+
+-- The time at which the todo was moved to the trash, or null if the todo is active.
+ALTER TABLE todos ADD COLUMN deleted_at timestamp;
+CREATE INDEX todos_deleted_at_idx ON todos (deleted_at);
+
+-- +goose Down
+
+DROP INDEX IF EXISTS todos_deleted_at_idx;
+ALTER TABLE todos DROP COLUMN deleted_at;
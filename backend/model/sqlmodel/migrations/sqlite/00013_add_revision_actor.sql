@@ -0,0 +1,11 @@
+-- +goose Up
+
+-- The kind of principal that made the revision, either user or service_account, or null if it was made anonymously.
+ALTER TABLE todo_revisions ADD COLUMN actor_kind text;
+-- The id of the user or service account that made the revision, or null if it was made anonymously.
+ALTER TABLE todo_revisions ADD COLUMN actor_id text;
+
+-- +goose Down
+
+ALTER TABLE todo_revisions DROP COLUMN actor_id;
+ALTER TABLE todo_revisions DROP COLUMN actor_kind;
@@ -0,0 +1,233 @@
+package sqlmodel
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/astromechza/todo-app/backend/model"
+)
+
+func (s *sqlModel) CreateServiceAccount(ctx context.Context, workspaceId string, params model.CreateServiceAccountsParams) (*model.ServiceAccount, error) {
+	if workspaceId == model.SharedWorkspaceId {
+		return nil, model.ErrBadRequest("the public workspace does not have service accounts")
+	}
+	if _, err := s.GetWorkspace(ctx, workspaceId); err != nil {
+		return nil, err
+	}
+	out := model.ServiceAccount{
+		Id:          model.NewWorkspaceId(),
+		WorkspaceId: workspaceId,
+		DisplayName: params.DisplayName,
+		CreatedAt:   time.Now().UTC(),
+	}
+	if _, err := s.db.ExecContext(
+		ctx,
+		`INSERT INTO service_accounts (id, workspace_id, display_name, created_at) VALUES (?, ?, ?, ?)`,
+		out.Id, out.WorkspaceId, out.DisplayName, out.CreatedAt,
+	); err != nil {
+		return nil, fmt.Errorf("failed to insert service account: %w", err)
+	}
+	return &out, nil
+}
+
+func (s *sqlModel) getServiceAccount(ctx context.Context, workspaceId string, id string) (*model.ServiceAccount, error) {
+	var out model.ServiceAccount
+	if err := s.db.QueryRowContext(
+		ctx,
+		`SELECT id, workspace_id, display_name, created_at FROM service_accounts WHERE workspace_id = ? AND id = ?`,
+		workspaceId, id,
+	).Scan(&out.Id, &out.WorkspaceId, &out.DisplayName, &out.CreatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			if _, err := s.GetWorkspace(ctx, workspaceId); err != nil {
+				return nil, err
+			}
+			return nil, model.ErrNotFound(fmt.Sprintf("service account '%s' not found", id))
+		}
+		return nil, fmt.Errorf("failed to query and scan service account: %w", err)
+	}
+	return &out, nil
+}
+
+func (s *sqlModel) ListServiceAccounts(ctx context.Context, workspaceId string) ([]model.ServiceAccount, error) {
+	if _, err := s.GetWorkspace(ctx, workspaceId); err != nil {
+		return nil, err
+	}
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT id, workspace_id, display_name, created_at FROM service_accounts WHERE workspace_id = ? ORDER BY id`,
+		workspaceId,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query service accounts: %w", err)
+	}
+	defer rows.Close()
+	out := make([]model.ServiceAccount, 0)
+	for rows.Next() {
+		var account model.ServiceAccount
+		if err := rows.Scan(&account.Id, &account.WorkspaceId, &account.DisplayName, &account.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan service account: %w", err)
+		}
+		out = append(out, account)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan service accounts: %w", err)
+	}
+	return out, nil
+}
+
+func (s *sqlModel) DeleteServiceAccount(ctx context.Context, workspaceId string, id string) error {
+	// the tokens of the service account are removed by the cascading foreign key
+	if res, err := s.db.ExecContext(
+		ctx, `DELETE FROM service_accounts WHERE workspace_id = ? AND id = ?`, workspaceId, id,
+	); err != nil {
+		return fmt.Errorf("failed to delete service account: %w", err)
+	} else if count, _ := res.RowsAffected(); count == 0 {
+		if _, err := s.GetWorkspace(ctx, workspaceId); err != nil {
+			return err
+		}
+		return model.ErrNotFound(fmt.Sprintf("service account '%s' not found", id))
+	}
+	return nil
+}
+
+func (s *sqlModel) CreateServiceAccountToken(ctx context.Context, workspaceId string, accountId string, params model.CreateServiceAccountTokensParams) (*model.ServiceAccountToken, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	account, err := s.getServiceAccount(ctx, workspaceId, accountId)
+	if err != nil {
+		return nil, err
+	}
+	out := model.NewServiceAccountToken(account, params)
+	scopes, err := json.Marshal(out.Scopes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal scopes: %w", err)
+	}
+	var groupIds sql.NullString
+	if len(out.GroupIds) > 0 {
+		raw, err := json.Marshal(out.GroupIds)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal group ids: %w", err)
+		}
+		groupIds = sql.NullString{String: string(raw), Valid: true}
+	}
+	if _, err := s.db.ExecContext(
+		ctx,
+		`INSERT INTO service_account_tokens (id, service_account_id, workspace_id, name, scopes, group_ids, created_at, expires_at, secret_hash)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		out.Id, out.ServiceAccountId, out.WorkspaceId, out.Name, string(scopes), groupIds, out.CreatedAt, out.ExpiresAt, model.HashApiKey(out.Secret),
+	); err != nil {
+		return nil, fmt.Errorf("failed to insert service account token: %w", err)
+	}
+	return &out, nil
+}
+
+// serviceAccountTokenColumns are the columns selected by scanServiceAccountToken, in order.
+const serviceAccountTokenColumns = `id, service_account_id, workspace_id, name, scopes, group_ids,
+	created_at, expires_at, last_used_at, revoked_at`
+
+func scanServiceAccountToken(row interface {
+	Scan(dest ...interface{}) error
+}) (*model.ServiceAccountToken, error) {
+	var out model.ServiceAccountToken
+	var scopes string
+	var groupIds sql.NullString
+	var lastUsedAt, revokedAt sql.NullTime
+	if err := row.Scan(
+		&out.Id, &out.ServiceAccountId, &out.WorkspaceId, &out.Name, &scopes, &groupIds,
+		&out.CreatedAt, &out.ExpiresAt, &lastUsedAt, &revokedAt,
+	); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(scopes), &out.Scopes); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal scopes: %w", err)
+	}
+	if groupIds.Valid {
+		if err := json.Unmarshal([]byte(groupIds.String), &out.GroupIds); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal group ids: %w", err)
+		}
+	}
+	if lastUsedAt.Valid {
+		out.LastUsedAt = &lastUsedAt.Time
+	}
+	if revokedAt.Valid {
+		out.RevokedAt = &revokedAt.Time
+	}
+	return &out, nil
+}
+
+func (s *sqlModel) ListServiceAccountTokens(ctx context.Context, workspaceId string, accountId string) ([]model.ServiceAccountToken, error) {
+	if _, err := s.getServiceAccount(ctx, workspaceId, accountId); err != nil {
+		return nil, err
+	}
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT `+serviceAccountTokenColumns+` FROM service_account_tokens WHERE service_account_id = ? ORDER BY id`,
+		accountId,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query service account tokens: %w", err)
+	}
+	defer rows.Close()
+	out := make([]model.ServiceAccountToken, 0)
+	for rows.Next() {
+		token, err := scanServiceAccountToken(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan service account token: %w", err)
+		}
+		out = append(out, *token)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan service account tokens: %w", err)
+	}
+	return out, nil
+}
+
+func (s *sqlModel) RevokeServiceAccountToken(ctx context.Context, workspaceId string, accountId string, id string) error {
+	if _, err := s.getServiceAccount(ctx, workspaceId, accountId); err != nil {
+		return err
+	}
+	if _, err := s.db.ExecContext(
+		ctx,
+		`UPDATE service_account_tokens SET revoked_at = ? WHERE service_account_id = ? AND id = ? AND revoked_at IS NULL`,
+		time.Now().UTC(), accountId, id,
+	); err != nil {
+		return fmt.Errorf("failed to revoke service account token: %w", err)
+	}
+	// the update does not affect a token that was already revoked, so the token is checked for separately
+	var exists int
+	if err := s.db.QueryRowContext(
+		ctx, `SELECT 1 FROM service_account_tokens WHERE service_account_id = ? AND id = ?`, accountId, id,
+	).Scan(&exists); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.ErrNotFound(fmt.Sprintf("service account token '%s' not found", id))
+		}
+		return fmt.Errorf("failed to query service account token: %w", err)
+	}
+	return nil
+}
+
+func (s *sqlModel) UseServiceAccountToken(ctx context.Context, secret string, at time.Time) (*model.ServiceAccountToken, error) {
+	at = at.UTC()
+	hash := model.HashApiKey(secret)
+	if res, err := s.db.ExecContext(
+		ctx,
+		`UPDATE service_account_tokens SET last_used_at = ? WHERE secret_hash = ? AND revoked_at IS NULL AND expires_at > ?`,
+		at, hash, at,
+	); err != nil {
+		return nil, fmt.Errorf("failed to update service account token: %w", err)
+	} else if count, _ := res.RowsAffected(); count == 0 {
+		return nil, model.ErrNotFound("service account token not found")
+	}
+	out, err := scanServiceAccountToken(s.db.QueryRowContext(
+		ctx, `SELECT `+serviceAccountTokenColumns+` FROM service_account_tokens WHERE secret_hash = ?`, hash,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query and scan service account token: %w", err)
+	}
+	return out, nil
+}
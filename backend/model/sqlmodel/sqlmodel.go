@@ -4,7 +4,6 @@ import (
 	"context"
 	"database/sql"
 	"embed"
-	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -17,6 +16,7 @@ import (
 	_ "github.com/jackc/pgx/v5"
 	pgxlib "github.com/jackc/pgx/v5/stdlib"
 	"github.com/pressly/goose/v3"
+	_ "modernc.org/sqlite"
 
 	"github.com/astromechza/todo-app/backend/model"
 	"github.com/astromechza/todo-app/pkg/ref"
@@ -26,24 +26,33 @@ func init() {
 	sql.Register("postgres", pgxlib.GetDefaultDriver())
 }
 
-func NewSqlModel(ctx context.Context, connString string) (model.Modelling, error) {
+// NewSqlModel connects to and migrates the database of the connection string. The page tokens of its lists are signed
+// with pageTokens.
+func NewSqlModel(ctx context.Context, connString string, pageTokens *model.PageTokenSigner) (model.Modelling, error) {
 	if !strings.Contains(connString, "://") {
 		return nil, fmt.Errorf("invalid database string, expected <driver>:// prefix")
 	}
-	driver := strings.Split(connString, "://")[0]
-	if driver == "mysql" {
-		connString = strings.TrimPrefix(connString, "mysql://")
+	scheme := strings.Split(connString, "://")[0]
+	d, ok := dialects[scheme]
+	if !ok {
+		return nil, fmt.Errorf("unsupported database scheme '%s'", scheme)
 	}
+	driver, connString := d.driver, d.dsn(connString)
 
 	// TODO: add sqltrace wrapper for opentracing
 	db, err := sql.Open(driver, connString)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database connection: '%s' '%s' %w", driver, connString, err)
 	}
-	db.SetMaxOpenConns(10)
-	db.SetMaxIdleConns(10)
-	db.SetConnMaxIdleTime(time.Minute * 10)
-	db.SetConnMaxLifetime(time.Hour)
+	if d.singleConnection {
+		db.SetMaxOpenConns(1)
+		db.SetMaxIdleConns(1)
+	} else {
+		db.SetMaxOpenConns(10)
+		db.SetMaxIdleConns(10)
+		db.SetConnMaxIdleTime(time.Minute * 10)
+		db.SetConnMaxLifetime(time.Hour)
+	}
 	logger := slog.With("driver", driver, "dsn", connString)
 
 	logger.Info("verifying sql connection")
@@ -66,9 +75,9 @@ func NewSqlModel(ctx context.Context, connString string) (model.Modelling, error
 		}
 	}
 	logger.Info("successfully connected to database")
-	modelling := &sqlModel{driver: driver, db: db}
+	modelling := &sqlModel{dialect: d, db: &database{DB: db, dialect: d}, pageTokens: pageTokens}
 
-	if err := goose.SetDialect(driver); err != nil {
+	if err := goose.SetDialect(d.gooseDialect); err != nil {
 		_ = db.Close()
 		return nil, fmt.Errorf("failed to set dialect: %w", err)
 	}
@@ -86,7 +95,7 @@ func NewSqlModel(ctx context.Context, connString string) (model.Modelling, error
 		}
 	}
 
-	if err := goose.UpContext(ctx, db, "migrations"); err != nil {
+	if err := goose.UpContext(ctx, db, d.migrations); err != nil {
 		_ = db.Close()
 		return nil, fmt.Errorf("failed to migrate: %w", err)
 	}
@@ -95,11 +104,12 @@ func NewSqlModel(ctx context.Context, connString string) (model.Modelling, error
 }
 
 type sqlModel struct {
-	driver string
-	db     *sql.DB
+	dialect    *dialect
+	db         *database
+	pageTokens *model.PageTokenSigner
 }
 
-//go:embed migrations/*.sql
+//go:embed migrations/*/*.sql
 var embedMigrations embed.FS
 
 type gooseLogger struct {
@@ -117,83 +127,227 @@ func (s *sqlModel) Close(ctx context.Context) error {
 	return s.db.Close()
 }
 
-func (s *sqlModel) GetTodo(ctx context.Context, workspaceId string, id string) (*model.Todo, error) {
-	groupPrefix, todoId := model.SplitGroupId(id)
+// todoColumns are the columns selected by scanTodo, in order.
+const todoColumns = `id, epoch, epoch_at, revision, revision_at,
+	group_id, group_epoch, workspace_id, workspace_epoch,
+	title, details, status, deleted_at, assignee_id, watchers`
+
+func scanTodo(row interface {
+	Scan(dest ...interface{}) error
+}) (*model.Todo, error) {
 	var out model.Todo
-	if err := s.db.QueryRowContext(
-		ctx,
-		`SELECT 
-    	id, epoch, epoch_at, revision, revision_at, 
-       	group_id, group_epoch, workspace_id, workspace_epoch,
-		title, details, status
-		FROM todos WHERE workspace_id = $1 AND group_id = $2 AND id = $3`,
-		workspaceId, groupPrefix, todoId,
-	).Scan(
+	var deletedAt sql.NullTime
+	var watchers sql.NullString
+	if err := row.Scan(
 		&out.Id, &out.Epoch, &out.EpochAt, &out.Revision, &out.RevisionAt,
 		&out.Group.Id, &out.Group.Epoch, &out.Workspace.Id, &out.Workspace.Epoch,
-		&out.Title, &out.Details, &out.Status,
+		&out.Title, &out.Details, &out.Status, &deletedAt, &out.Assignee, &watchers,
 	); err != nil {
+		return nil, err
+	}
+	if deletedAt.Valid {
+		out.DeletedAt = &deletedAt.Time
+	}
+	if watchers.Valid {
+		if err := json.Unmarshal([]byte(watchers.String), &out.Watchers); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal watchers: %w", err)
+		}
+	}
+	return &out, nil
+}
+
+// encodeWatchers returns the json encoded watchers of a todo, or null if there are none.
+func encodeWatchers(watchers []string) (sql.NullString, error) {
+	if len(watchers) == 0 {
+		return sql.NullString{}, nil
+	}
+	raw, err := json.Marshal(watchers)
+	if err != nil {
+		return sql.NullString{}, fmt.Errorf("failed to marshal watchers: %w", err)
+	}
+	return sql.NullString{String: string(raw), Valid: true}, nil
+}
+
+// rankedRow scans the search rank that follows the todo columns in a ranked query.
+type rankedRow struct {
+	*sql.Rows
+	rank *float64
+}
+
+func (r rankedRow) Scan(dest ...interface{}) error {
+	return r.Rows.Scan(append(dest, r.rank)...)
+}
+
+// trashCondition returns the condition that matches either the todos in the trash or the active todos.
+func trashCondition(trashed bool) string {
+	if trashed {
+		return "deleted_at IS NOT NULL"
+	}
+	return "deleted_at IS NULL"
+}
+
+func (s *sqlModel) GetTodo(ctx context.Context, workspaceId string, id string) (*model.Todo, error) {
+	return getTodo(ctx, s.db, workspaceId, id, false)
+}
+
+// getTodo returns either the active todo or the todo in the trash with the given id.
+func getTodo(ctx context.Context, q queryer, workspaceId string, id string, trashed bool) (*model.Todo, error) {
+	groupPrefix, todoId := model.SplitGroupId(id)
+	out, err := scanTodo(q.QueryRowContext(
+		ctx,
+		`SELECT `+todoColumns+` FROM todos WHERE workspace_id = ? AND group_id = ? AND id = ? AND `+trashCondition(trashed),
+		workspaceId, groupPrefix, todoId,
+	))
+	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
+			if trashed {
+				return nil, model.ErrNotFound("todo not found in trash")
+			}
 			return nil, model.ErrNotFound("todo not found")
 		}
 		return nil, fmt.Errorf("failed to query and scan todo: %w", err)
 	}
-	return &out, nil
+	return out, nil
 }
 
 func (s *sqlModel) ListTodos(ctx context.Context, workspaceId string, params model.ListTodosParams) (*model.ListTodosPage, error) {
 	var pageToken struct {
-		LastGroupId string `json:"g"`
-		LastId      int64  `json:"i"`
+		LastGroupId string            `json:"g"`
+		LastId      int64             `json:"i"`
+		LastRank    float64           `json:"r,omitempty"`
+		LastKey     string            `json:"k,omitempty"`
+		Filter      *model.TodoFilter `json:"f,omitempty"`
+		Sort        *model.TodoSort   `json:"o,omitempty"`
 	}
 
-	if params.PageToken != nil {
-		rawToken, err := base64.RawURLEncoding.DecodeString(*params.PageToken)
-		if err != nil {
-			return nil, model.ErrBadRequest("failed to decode page token")
+	if err := s.pageTokens.Decode(params.PageToken, model.TodosPageScope(workspaceId), &pageToken); err != nil {
+		return nil, err
+	}
+	if err := params.TodoFilter.Resolve(pageToken.Filter); err != nil {
+		return nil, err
+	}
+	if err := params.Sort.Resolve(pageToken.Sort); err != nil {
+		return nil, err
+	}
+	pageToken.Filter, pageToken.Sort = &params.TodoFilter, &params.Sort
+
+	limit, err := model.PageLimit(params.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.GetWorkspace(ctx, workspaceId); err != nil {
+		return nil, err
+	}
+
+	groupCondition, groupArgs := inCondition("group_id", params.ByGroup)
+	statusCondition, statusArgs := inCondition("status", params.ByStatus)
+	filter := sqlExpr{
+		sql:  `workspace_id = ? AND ` + trashCondition(params.Trashed) + ` AND ` + groupCondition + ` AND ` + statusCondition,
+		args: append(append([]interface{}{workspaceId}, groupArgs...), statusArgs...),
+	}
+	if params.ByAssignee != nil && *params.ByAssignee == "" {
+		filter.sql += ` AND assignee_id IS NULL`
+	} else if params.ByAssignee != nil {
+		filter.sql += ` AND assignee_id = ?`
+		filter.args = append(filter.args, *params.ByAssignee)
+	}
+	for _, bound := range []struct {
+		condition string
+		value     *time.Time
+	}{
+		{`epoch_at >= ?`, params.CreatedAfter},
+		{`epoch_at < ?`, params.CreatedBefore},
+		{`revision_at >= ?`, params.UpdatedAfter},
+		{`revision_at < ?`, params.UpdatedBefore},
+	} {
+		if bound.value != nil {
+			filter.sql += ` AND ` + bound.condition
+			filter.args = append(filter.args, *bound.value)
 		}
-		if err := json.Unmarshal(rawToken, &pageToken); err != nil {
-			return nil, model.ErrBadRequest("failed to unmarshal page token")
+	}
+	// without a search every todo has the same rank, so the order is by group and id alone
+	rank := sqlExpr{sql: "0"}
+	searching := len(model.SearchTerms(params.Query)) > 0
+	if searching {
+		var match sqlExpr
+		match, rank = s.dialect.search(*params.Query)
+		filter.sql += ` AND ` + match.sql
+		filter.args = append(filter.args, match.args...)
+	}
+	// the todos are ordered by the sort field if there is one or by descending rank when searching, then by group
+	// and id
+	var order *sqlExpr
+	var orderColumn string
+	descending := params.Sort.Descending
+	switch params.Sort.Field {
+	case model.TodoSortCreatedAt:
+		order, orderColumn = &sqlExpr{sql: "epoch_at"}, "epoch_at"
+	case model.TodoSortUpdatedAt:
+		order, orderColumn = &sqlExpr{sql: "revision_at"}, "revision_at"
+	case model.TodoSortTitle:
+		order, orderColumn = &sqlExpr{sql: s.dialect.titleOrder}, s.dialect.titleOrder
+	default:
+		if searching {
+			order, orderColumn, descending = &rank, "search_rank", true
 		}
 	}
-
-	limit := 20
-	if params.PageSize != nil {
-		if *params.PageSize < 1 || *params.PageSize > 1000 {
-			return nil, model.ErrBadRequest("page size out of range [1,1000]")
+	orderBy := `group_id, id`
+	if order != nil {
+		direction := ` ASC`
+		if descending {
+			direction = ` DESC`
+		}
+		orderBy = orderColumn + direction + `, ` + orderBy
+	}
+	// after returns the condition that matches the todos following the last todo of the previous page
+	after := func() (sqlExpr, error) {
+		out := sqlExpr{sql: `(group_id > ? OR (group_id = ? AND id > ?))`, args: []interface{}{pageToken.LastGroupId, pageToken.LastGroupId, pageToken.LastId}}
+		if order == nil {
+			return out, nil
+		}
+		var last interface{} = pageToken.LastRank
+		if params.Sort.Field != model.TodoSortDefault {
+			var err error
+			if last, err = params.Sort.ParseKey(pageToken.LastKey); err != nil {
+				return sqlExpr{}, err
+			}
+		}
+		comparison := ` > ?`
+		if descending {
+			comparison = ` < ?`
+		}
+		out.sql = `(` + order.sql + comparison + ` OR (` + order.sql + ` = ? AND ` + out.sql + `))`
+		args := append(append([]interface{}{}, order.args...), last)
+		args = append(append(args, order.args...), last)
+		out.args = append(args, out.args...)
+		return out, nil
+	}
+	keyset := sqlExpr{sql: "1 = 1"}
+	if params.PageToken != nil {
+		if keyset, err = after(); err != nil {
+			return nil, err
 		}
 	}
 
 	rows, err := s.db.QueryContext(
 		ctx,
-		`SELECT
-    	id, epoch, epoch_at, revision, revision_at, 
-       	group_id, group_epoch, workspace_id, workspace_epoch,
-		title, details, status
-		FROM todos 
-		WHERE workspace_id = $1
-	    AND ($2::text[] IS NULL OR group_id = ANY($2::text[]))
-	    AND ($3::text[] IS NULL OR status = ANY($3::text[]))
-	  	AND group_id > $4 OR (group_id = $4 AND id > $5)
-		ORDER BY workspace_id, group_id, id
-	  	LIMIT $6`,
-		workspaceId, params.ByGroup, params.ByStatus, pageToken.LastGroupId, pageToken.LastId, limit,
+		`SELECT `+todoColumns+`, `+rank.sql+` AS search_rank FROM todos WHERE `+filter.sql+` AND `+keyset.sql+`
+		ORDER BY `+orderBy+` LIMIT ?`,
+		append(append(append(append([]interface{}{}, rank.args...), filter.args...), keyset.args...), limit)...,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query todos: %w", err)
 	}
 	defer rows.Close()
 	outRows := make([]model.Todo, 0)
+	var lastRank float64
 	for rows.Next() {
-		var out model.Todo
-		if err := rows.Scan(
-			&out.Id, &out.Epoch, &out.EpochAt, &out.Revision, &out.RevisionAt,
-			&out.Group.Id, &out.Group.Epoch, &out.Workspace.Id, &out.Workspace.Epoch,
-			&out.Title, &out.Details, &out.Status,
-		); err != nil {
+		out, err := scanTodo(rankedRow{Rows: rows, rank: &lastRank})
+		if err != nil {
 			return nil, fmt.Errorf("failed to scan todo: %w", err)
 		}
-		outRows = append(outRows, out)
+		outRows = append(outRows, *out)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("failed to scan todos: %w", err)
@@ -203,16 +357,14 @@ func (s *sqlModel) ListTodos(ctx context.Context, workspaceId string, params mod
 	if len(outRows) > 0 {
 		pageToken.LastGroupId = outRows[len(outRows)-1].Group.Id
 		pageToken.LastId = outRows[len(outRows)-1].Id
+		pageToken.LastRank = lastRank
+		pageToken.LastKey = params.Sort.Key(&outRows[len(outRows)-1])
+		if keyset, err = after(); err != nil {
+			return nil, err
+		}
 	}
 	if err := s.db.QueryRowContext(
-		ctx,
-		`SELECT COUNT(*)
-		FROM todos 
-		WHERE workspace_id = $1
-	    AND ($2::text[] IS NULL OR group_id = ANY($2::text[]))
-	    AND ($3::text[] IS NULL OR status = ANY($3::text[]))
-	  	AND group_id > $4 OR (group_id = $4 AND id > $5)`,
-		workspaceId, params.ByGroup, params.ByStatus, pageToken.LastGroupId, pageToken.LastId,
+		ctx, `SELECT COUNT(*) FROM todos WHERE `+filter.sql+` AND `+keyset.sql, append(append([]interface{}{}, filter.args...), keyset.args...)...,
 	).Scan(&remaining); err != nil {
 		return nil, fmt.Errorf("failed to query and scan remaining count: %w", err)
 	}
@@ -222,10 +374,8 @@ func (s *sqlModel) ListTodos(ctx context.Context, workspaceId string, params mod
 		RemainingItems: remaining,
 	}
 	if len(outRows) > 0 && remaining > 0 {
-		if raw, err := json.Marshal(pageToken); err != nil {
-			return nil, fmt.Errorf("failed to marshal page token: %w", err)
-		} else {
-			page.NextPageToken = ref.Ref(base64.RawURLEncoding.EncodeToString(raw))
+		if page.NextPageToken, err = s.pageTokens.Encode(model.TodosPageScope(workspaceId), pageToken); err != nil {
+			return nil, err
 		}
 	}
 
@@ -233,29 +383,101 @@ func (s *sqlModel) ListTodos(ctx context.Context, workspaceId string, params mod
 }
 
 func (s *sqlModel) CreateTodo(ctx context.Context, workspaceId string, params model.CreateTodosParams) (*model.Todo, error) {
-	// TODO: actually need to validate this workspace and workspace epoch from somewhere.
-	//		this should require that we can check against our in-memory workspace data source?
-	// 		for now we're going to assume workspaces only have 1 epoch
+	workspace, err := s.GetWorkspace(ctx, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+	workflow, err := s.GetWorkflow(ctx, workspaceId)
+	if err != nil {
+		return nil, err
+	}
 
-	if workspaceId != model.SharedWorkspaceId {
-		return nil, model.ErrNotFound(fmt.Sprintf("workspace '%s' not found", workspaceId))
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
 	}
+	defer tx.Rollback()
 
-	var workspaceEpoch int64
-	var groupEpoch int64
-	var nextId int
-	if err := s.db.QueryRowContext(
-		ctx,
-		`INSERT INTO todos_groups (id, epoch, epoch_at, workspace_id, workspace_epoch, last_serial) VALUES ($1, $2, $3, $4, $5, $6)
-			ON CONFLICT (workspace_id, id) DO UPDATE SET last_serial = todos_groups.last_serial + 1 RETURNING workspace_epoch, epoch, last_serial`,
-		params.GroupId, model.DefaultGroupEpoch, time.Now().UTC(), workspaceId, model.DefaultWorkspaceEpoch, 1,
-	).Scan(&workspaceEpoch, &groupEpoch, &nextId); err != nil {
-		return nil, fmt.Errorf("failed to create or increment group: %w", err)
+	out, err := s.createTodo(ctx, tx, workspace, workflow, params)
+	if err != nil {
+		return nil, err
 	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit todo: %w", err)
+	}
+	return out, nil
+}
 
+// createTodo creates the todo within the transaction.
+func (s *sqlModel) createTodo(ctx context.Context, tx *transaction, workspace *model.Workspace, workflow *model.Workflow, params model.CreateTodosParams) (*model.Todo, error) {
+	workspaceId := workspace.Id
+	status, err := workflow.InitialStatusOr(params.Status)
+	if err != nil {
+		return nil, err
+	}
+	watchers, err := model.NormaliseWatchers(params.Watchers)
+	if err != nil {
+		return nil, err
+	}
+	if params.Assignee != nil && *params.Assignee == "" {
+		params.Assignee = nil
+	}
+	if params.Details != nil && *params.Details == "" {
+		params.Details = nil
+	}
+	if err := checkPeople(ctx, tx, workspaceId, model.TodoPeople(params.Assignee, watchers)); err != nil {
+		return nil, err
+	}
+	rawWatchers, err := encodeWatchers(watchers)
+	if err != nil {
+		return nil, err
+	}
+	// make sure the group exists and then claim the next serial, the update holds the row lock until commit
 	now := time.Now().UTC()
+	if _, err := insertIfAbsent(
+		ctx, tx, s.dialect,
+		`INSERT INTO todos_groups (id, epoch, epoch_at, workspace_id, workspace_epoch, last_serial) VALUES (?, ?, ?, ?, ?, 0)`,
+		params.GroupId, rand.Int63(), now, workspaceId, workspace.Epoch,
+	); err != nil {
+		return nil, fmt.Errorf("failed to create group: %w", err)
+	}
+	// a preferred id is used if it is free, and the serial moves past it so that later todos do not collide with it
+	serial := `last_serial + 1`
+	var serialArgs []interface{}
+	if params.Id != nil && *params.Id > 0 {
+		// lock the group before checking the id so that a concurrent create cannot claim it in the meantime
+		if _, err := tx.ExecContext(
+			ctx, `UPDATE todos_groups SET last_serial = last_serial WHERE workspace_id = ? AND id = ?`, workspaceId, params.GroupId,
+		); err != nil {
+			return nil, fmt.Errorf("failed to lock group: %w", err)
+		}
+		var taken int
+		if err := tx.QueryRowContext(
+			ctx, `SELECT COUNT(*) FROM todos WHERE workspace_id = ? AND group_id = ? AND id = ?`, workspaceId, params.GroupId, *params.Id,
+		).Scan(&taken); err != nil {
+			return nil, fmt.Errorf("failed to query and scan todo id: %w", err)
+		} else if taken == 0 {
+			serial = `CASE WHEN last_serial < ? THEN ? ELSE last_serial END`
+			serialArgs = []interface{}{*params.Id, *params.Id}
+		}
+	}
+	if _, err := tx.ExecContext(
+		ctx, `UPDATE todos_groups SET last_serial = `+serial+` WHERE workspace_id = ? AND id = ?`, append(serialArgs, workspaceId, params.GroupId)...,
+	); err != nil {
+		return nil, fmt.Errorf("failed to increment group: %w", err)
+	}
+	var workspaceEpoch, groupEpoch, nextId int64
+	if err := tx.QueryRowContext(
+		ctx, `SELECT workspace_epoch, epoch, last_serial FROM todos_groups WHERE workspace_id = ? AND id = ?`, workspaceId, params.GroupId,
+	).Scan(&workspaceEpoch, &groupEpoch, &nextId); err != nil {
+		return nil, fmt.Errorf("failed to query and scan group: %w", err)
+	}
+	if serialArgs != nil {
+		nextId = *params.Id
+	}
+
 	out := model.Todo{
-		Id:         int64(nextId),
+		Id:         nextId,
 		Epoch:      rand.Int63(),
 		EpochAt:    now,
 		Revision:   0,
@@ -268,45 +490,270 @@ func (s *sqlModel) CreateTodo(ctx context.Context, workspaceId string, params mo
 			Id:    params.GroupId,
 			Epoch: groupEpoch,
 		},
-		Title:   params.Title,
-		Status:  "open",
-		Details: params.Details,
+		Title:    params.Title,
+		Status:   status,
+		Details:  params.Details,
+		Assignee: params.Assignee,
+		Watchers: watchers,
 	}
 
-	if res, err := s.db.ExecContext(
+	if _, err := tx.ExecContext(
 		ctx,
-		`INSERT INTO todos (id, epoch, epoch_at, revision, revision_at, group_id, group_epoch, workspace_id, workspace_epoch, title, details, status) 
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+		`INSERT INTO todos (id, epoch, epoch_at, revision, revision_at, group_id, group_epoch, workspace_id, workspace_epoch, title, details, status, assignee_id, watchers) 
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 		out.Id, out.Epoch, out.EpochAt, out.Revision, out.RevisionAt, out.Group.Id, out.Group.Epoch, out.Workspace.Id, out.Workspace.Epoch, out.Title, ref.DeRefToNullString(out.Details), out.Status,
+		ref.DeRefToNullString(out.Assignee), rawWatchers,
 	); err != nil {
 		return nil, fmt.Errorf("failed to insert todo: %w", err)
-	} else if count, _ := res.RowsAffected(); count == 0 {
-		return nil, fmt.Errorf("no rows inserted")
+	}
+	if err := insertRevision(ctx, tx, &out, model.RevisionActionCreated, model.DiffTodo(nil, &out)); err != nil {
+		return nil, err
 	}
 	return &out, nil
 }
 
-func (s *sqlModel) DeleteTodo(ctx context.Context, workspaceId string, id string, params model.DeleteTodosParams) error {
-	groupId, todoId := model.SplitGroupId(id)
-	var deleted bool
-	var returnedRevision int64
-	if err := s.db.QueryRowContext(
+func (s *sqlModel) UpdateTodo(ctx context.Context, workspaceId string, id string, params model.UpdateTodosParams) (*model.Todo, error) {
+	var workflow *model.Workflow
+	if params.Status != nil {
+		var err error
+		if workflow, err = s.GetWorkflow(ctx, workspaceId); err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	out, err := updateTodo(ctx, tx, workflow, workspaceId, id, params)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit todo: %w", err)
+	}
+	return out, nil
+}
+
+// updateTodo updates the todo within the transaction, the workflow is only needed when the status changes.
+func updateTodo(ctx context.Context, tx *transaction, workflow *model.Workflow, workspaceId string, id string, params model.UpdateTodosParams) (*model.Todo, error) {
+	current, err := getTodo(ctx, tx, workspaceId, id, false)
+	if err != nil {
+		return nil, err
+	} else if params.Epoch != nil && (*params.Epoch != current.Epoch || params.Revision != current.Revision) {
+		return nil, model.ErrPreconditionFailed("todo does not match the requested epoch and revision")
+	} else if current.Revision != params.Revision {
+		return nil, model.ErrConflict(fmt.Sprintf("revision %d does not match current revision %d", params.Revision, current.Revision))
+	}
+	if workflow != nil {
+		if err := workflow.CheckTransition(current.Status, *params.Status); err != nil {
+			return nil, err
+		}
+	}
+	set := sqlExpr{
+		sql:  `title = COALESCE(?, title), status = COALESCE(?, status)`,
+		args: []interface{}{ref.DeRefToNullString(params.Title), ref.DeRefToNullString(params.Status)},
+	}
+	if params.Details != nil {
+		set.sql += `, details = ?`
+		set.args = append(set.args, sql.NullString{String: *params.Details, Valid: *params.Details != ""})
+	}
+	var watchers []string
+	if params.Watchers != nil {
+		if watchers, err = model.NormaliseWatchers(*params.Watchers); err != nil {
+			return nil, err
+		}
+		rawWatchers, err := encodeWatchers(watchers)
+		if err != nil {
+			return nil, err
+		}
+		set.sql += `, watchers = ?`
+		set.args = append(set.args, rawWatchers)
+	}
+	if params.Assignee != nil {
+		set.sql += `, assignee_id = ?`
+		set.args = append(set.args, sql.NullString{String: *params.Assignee, Valid: *params.Assignee != ""})
+	}
+	if err := checkPeople(ctx, tx, workspaceId, model.TodoPeople(params.Assignee, watchers)); err != nil {
+		return nil, err
+	}
+
+	// the revision condition guards against a concurrent update that happened after the todo was read
+	if res, err := tx.ExecContext(
 		ctx,
-		`WITH deleted_revisions AS (
-    		DELETE FROM todos WHERE workspace_id = $1 AND group_id = $2 AND id = $3 AND ($4 = 0 OR epoch = $4) AND ($5 = 0 OR revision = $5) RETURNING true, revision
-		), remaining_revisions AS (
-			SELECT false, revision FROM todos WHERE workspace_id = $1 AND group_id = $2 AND id = $3 AND ($4 = 0 OR epoch = $4) AND ($5 != 0 AND revision != $5)
-		)
-		SELECT * FROM deleted_revisions UNION ALL SELECT * FROM remaining_revisions`,
-		workspaceId, groupId, todoId, ref.DeRefOr(params.Epoch, 0), ref.DeRefOr(params.Revision, 0),
-	).Scan(&deleted, &returnedRevision); err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return model.ErrNotFound("todo not found")
+		`UPDATE todos SET `+set.sql+`, revision = revision + 1, revision_at = ?
+		WHERE workspace_id = ? AND group_id = ? AND id = ? AND revision = ? AND deleted_at IS NULL`,
+		append(set.args, time.Now().UTC(), workspaceId, current.Group.Id, current.Id, params.Revision)...,
+	); err != nil {
+		return nil, fmt.Errorf("failed to update todo: %w", err)
+	} else if count, _ := res.RowsAffected(); count == 0 {
+		if params.Epoch != nil {
+			return nil, model.ErrPreconditionFailed("todo was modified concurrently")
 		}
-		return fmt.Errorf("failed to exec delete: %w", err)
+		return nil, model.ErrConflict(fmt.Sprintf("revision %d does not match current revision", params.Revision))
+	}
+
+	out, err := getTodo(ctx, tx, workspaceId, id, false)
+	if err != nil {
+		return nil, err
+	}
+	if err := insertRevision(ctx, tx, out, model.RevisionActionUpdated, model.DiffTodo(current, out)); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (s *sqlModel) DeleteTodo(ctx context.Context, workspaceId string, id string, params model.DeleteTodosParams) (*model.Todo, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
 	}
-	if deleted {
-		return nil
+	defer tx.Rollback()
+
+	out, err := deleteTodo(ctx, tx, workspaceId, id, params)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit delete: %w", err)
+	}
+	return out, nil
+}
+
+// deleteTodo moves the todo to the trash within the transaction and returns the trashed todo.
+func deleteTodo(ctx context.Context, tx *transaction, workspaceId string, id string, params model.DeleteTodosParams) (*model.Todo, error) {
+	current, err := getTodo(ctx, tx, workspaceId, id, false)
+	if err != nil {
+		return nil, err
+	}
+	if params.Epoch != nil && *params.Epoch != current.Epoch {
+		return nil, model.ErrPreconditionFailed("todo does not match the requested epoch")
+	}
+	if params.Revision != nil && *params.Revision != current.Revision {
+		return nil, model.ErrPreconditionFailed("todo does not match the requested revision")
+	}
+
+	now := time.Now().UTC()
+	if res, err := tx.ExecContext(
+		ctx,
+		`UPDATE todos SET deleted_at = ?, revision = revision + 1, revision_at = ?
+		WHERE workspace_id = ? AND group_id = ? AND id = ? AND revision = ? AND deleted_at IS NULL`,
+		now, now, workspaceId, current.Group.Id, current.Id, current.Revision,
+	); err != nil {
+		return nil, fmt.Errorf("failed to move todo to trash: %w", err)
+	} else if count, _ := res.RowsAffected(); count == 0 {
+		return nil, model.ErrPreconditionFailed("todo was modified concurrently")
+	}
+	current.Revision, current.RevisionAt, current.DeletedAt = current.Revision+1, now, &now
+	if err := insertRevision(ctx, tx, current, model.RevisionActionDeleted, nil); err != nil {
+		return nil, err
+	}
+	return current, nil
+}
+
+func (s *sqlModel) BatchTodos(ctx context.Context, workspaceId string, params model.BatchTodosParams) ([]model.TodoOperationResult, error) {
+	if err := model.CheckBatch(params); err != nil {
+		return nil, err
+	}
+	workspace, err := s.GetWorkspace(ctx, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+	workflow, err := s.GetWorkflow(ctx, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	results := make([]model.TodoOperationResult, len(params.Operations))
+	for i, op := range params.Operations {
+		// in a partial batch each operation runs in a savepoint so that a failed one can be undone on its own
+		if params.Partial {
+			if _, err := tx.ExecContext(ctx, `SAVEPOINT batch_operation`); err != nil {
+				return nil, fmt.Errorf("failed to create savepoint: %w", err)
+			}
+		}
+		if err := op.Check(); err != nil {
+			results[i].Err = err
+		} else {
+			switch op.Kind {
+			case model.TodoOperationCreate:
+				results[i].Todo, results[i].Err = s.createTodo(ctx, tx, workspace, workflow, op.Create)
+			case model.TodoOperationUpdate:
+				results[i].Todo, results[i].Err = updateTodo(ctx, tx, workflow, workspaceId, op.TodoId, op.Update)
+			case model.TodoOperationDelete:
+				results[i].Todo, results[i].Err = deleteTodo(ctx, tx, workspaceId, op.TodoId, op.Delete)
+			}
+		}
+		if results[i].Err != nil && (!params.Partial || !model.IsOperationError(results[i].Err)) {
+			return nil, &model.BatchError{Index: i, Err: results[i].Err}
+		}
+		if params.Partial {
+			if results[i].Err != nil {
+				if _, err := tx.ExecContext(ctx, `ROLLBACK TO SAVEPOINT batch_operation`); err != nil {
+					return nil, fmt.Errorf("failed to roll back to savepoint: %w", err)
+				}
+			}
+			if _, err := tx.ExecContext(ctx, `RELEASE SAVEPOINT batch_operation`); err != nil {
+				return nil, fmt.Errorf("failed to release savepoint: %w", err)
+			}
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit batch: %w", err)
+	}
+	return results, nil
+}
+
+func (s *sqlModel) RestoreTodo(ctx context.Context, workspaceId string, id string) (*model.Todo, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	current, err := getTodo(ctx, tx, workspaceId, id, true)
+	if err != nil {
+		return nil, err
+	}
+	if res, err := tx.ExecContext(
+		ctx,
+		`UPDATE todos SET deleted_at = NULL, revision = revision + 1, revision_at = ?
+		WHERE workspace_id = ? AND group_id = ? AND id = ? AND revision = ? AND deleted_at IS NOT NULL`,
+		time.Now().UTC(), workspaceId, current.Group.Id, current.Id, current.Revision,
+	); err != nil {
+		return nil, fmt.Errorf("failed to restore todo: %w", err)
+	} else if count, _ := res.RowsAffected(); count == 0 {
+		return nil, model.ErrConflict("todo was modified concurrently")
+	}
+	out, err := getTodo(ctx, tx, workspaceId, id, false)
+	if err != nil {
+		return nil, err
+	}
+	if err := insertRevision(ctx, tx, out, model.RevisionActionRestored, nil); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit restore: %w", err)
+	}
+	return out, nil
+}
+
+func (s *sqlModel) PurgeTrash(ctx context.Context, before time.Time) (int, error) {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM todos WHERE deleted_at IS NOT NULL AND deleted_at < ?`, before.UTC())
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge trash: %w", err)
+	}
+	count, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count purged todos: %w", err)
 	}
-	return model.ErrBadRequest("incorrect revision number")
+	return int(count), nil
 }
@@ -0,0 +1,426 @@
+package sqlmodel
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/astromechza/todo-app/backend/model"
+	"github.com/astromechza/todo-app/pkg/ref"
+)
+
+func (s *sqlModel) CreateUser(ctx context.Context, params model.CreateUsersParams) (*model.User, error) {
+	out := model.User{
+		Id:          ref.DeRefOr(params.Id, model.NewUserId()),
+		EpochAt:     time.Now().UTC(),
+		DisplayName: params.DisplayName,
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if inserted, err := insertIfAbsent(
+		ctx, tx, s.dialect,
+		`INSERT INTO users (id, epoch_at, display_name) VALUES (?, ?, ?)`,
+		out.Id, out.EpochAt, out.DisplayName,
+	); err != nil {
+		return nil, fmt.Errorf("failed to insert user: %w", err)
+	} else if !inserted {
+		return nil, model.ErrConflict(fmt.Sprintf("user '%s' already exists", out.Id))
+	}
+	if params.Identity != nil {
+		if inserted, err := insertIfAbsent(
+			ctx, tx, s.dialect,
+			`INSERT INTO user_identities (issuer, subject, user_id) VALUES (?, ?, ?)`,
+			params.Identity.Issuer, params.Identity.Subject, out.Id,
+		); err != nil {
+			return nil, fmt.Errorf("failed to insert user identity: %w", err)
+		} else if !inserted {
+			return nil, model.ErrConflict("the identity is already linked to a user")
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit user: %w", err)
+	}
+	return &out, nil
+}
+
+func getUser(ctx context.Context, q queryer, id string) (*model.User, error) {
+	var out model.User
+	if err := q.QueryRowContext(
+		ctx,
+		`SELECT id, epoch_at, display_name FROM users WHERE id = ?`,
+		id,
+	).Scan(&out.Id, &out.EpochAt, &out.DisplayName); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrNotFound(fmt.Sprintf("user '%s' not found", id))
+		}
+		return nil, fmt.Errorf("failed to query and scan user: %w", err)
+	}
+	return &out, nil
+}
+
+func (s *sqlModel) GetUser(ctx context.Context, id string) (*model.User, error) {
+	return getUser(ctx, s.db, id)
+}
+
+func (s *sqlModel) GetUserByApiKey(ctx context.Context, secret string) (*model.User, error) {
+	var out model.User
+	if err := s.db.QueryRowContext(
+		ctx,
+		`SELECT users.id, users.epoch_at, users.display_name FROM api_keys JOIN users ON users.id = api_keys.user_id
+		WHERE api_keys.secret_hash = ?`,
+		model.HashApiKey(secret),
+	).Scan(&out.Id, &out.EpochAt, &out.DisplayName); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrNotFound("api key not found")
+		}
+		return nil, fmt.Errorf("failed to query and scan api key user: %w", err)
+	}
+	return &out, nil
+}
+
+func (s *sqlModel) GetUserByIdentity(ctx context.Context, identity model.UserIdentity) (*model.User, error) {
+	var out model.User
+	if err := s.db.QueryRowContext(
+		ctx,
+		`SELECT users.id, users.epoch_at, users.display_name FROM user_identities JOIN users ON users.id = user_identities.user_id
+		WHERE user_identities.issuer = ? AND user_identities.subject = ?`,
+		identity.Issuer, identity.Subject,
+	).Scan(&out.Id, &out.EpochAt, &out.DisplayName); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrNotFound("no user is linked to the identity")
+		}
+		return nil, fmt.Errorf("failed to query and scan identity user: %w", err)
+	}
+	return &out, nil
+}
+
+func (s *sqlModel) CreateApiKey(ctx context.Context, userId string, params model.CreateApiKeysParams) (*model.ApiKey, error) {
+	if _, err := s.GetUser(ctx, userId); err != nil {
+		return nil, err
+	}
+	out := model.NewApiKey(userId, params)
+	if _, err := s.db.ExecContext(
+		ctx,
+		`INSERT INTO api_keys (id, user_id, name, created_at, secret_hash) VALUES (?, ?, ?, ?, ?)`,
+		out.Id, out.UserId, out.Name, out.CreatedAt, model.HashApiKey(out.Secret),
+	); err != nil {
+		return nil, fmt.Errorf("failed to insert api key: %w", err)
+	}
+	return &out, nil
+}
+
+func (s *sqlModel) ListApiKeys(ctx context.Context, userId string) ([]model.ApiKey, error) {
+	if _, err := s.GetUser(ctx, userId); err != nil {
+		return nil, err
+	}
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT id, user_id, name, created_at FROM api_keys WHERE user_id = ? ORDER BY id`,
+		userId,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query api keys: %w", err)
+	}
+	defer rows.Close()
+	out := make([]model.ApiKey, 0)
+	for rows.Next() {
+		var key model.ApiKey
+		if err := rows.Scan(&key.Id, &key.UserId, &key.Name, &key.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan api key: %w", err)
+		}
+		out = append(out, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan api keys: %w", err)
+	}
+	return out, nil
+}
+
+func (s *sqlModel) DeleteApiKey(ctx context.Context, userId string, id string) error {
+	if res, err := s.db.ExecContext(ctx, `DELETE FROM api_keys WHERE user_id = ? AND id = ?`, userId, id); err != nil {
+		return fmt.Errorf("failed to delete api key: %w", err)
+	} else if count, _ := res.RowsAffected(); count == 0 {
+		return model.ErrNotFound(fmt.Sprintf("api key '%s' not found", id))
+	}
+	return nil
+}
+
+// workspaceMemberColumns are the columns selected by scanWorkspaceMember, in order.
+const workspaceMemberColumns = `workspace_id, user_id, added_at, role, group_roles`
+
+func scanWorkspaceMember(row interface {
+	Scan(dest ...interface{}) error
+}) (*model.WorkspaceMember, error) {
+	var out model.WorkspaceMember
+	var groupRoles sql.NullString
+	if err := row.Scan(&out.WorkspaceId, &out.UserId, &out.AddedAt, &out.Role, &groupRoles); err != nil {
+		return nil, err
+	}
+	if groupRoles.Valid {
+		if err := json.Unmarshal([]byte(groupRoles.String), &out.GroupRoles); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal group roles: %w", err)
+		}
+	}
+	return &out, nil
+}
+
+func (s *sqlModel) GetWorkspaceMember(ctx context.Context, workspaceId string, userId string) (*model.WorkspaceMember, error) {
+	out, err := scanWorkspaceMember(s.db.QueryRowContext(
+		ctx,
+		`SELECT `+workspaceMemberColumns+` FROM workspace_members WHERE workspace_id = ? AND user_id = ?`,
+		workspaceId, userId,
+	))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			if _, err := s.GetWorkspace(ctx, workspaceId); err != nil {
+				return nil, err
+			}
+			return nil, model.ErrNotFound(fmt.Sprintf("user '%s' is not a member of the workspace", userId))
+		}
+		return nil, fmt.Errorf("failed to query and scan workspace member: %w", err)
+	}
+	return out, nil
+}
+
+func (s *sqlModel) ListWorkspaceMembers(ctx context.Context, workspaceId string) ([]model.WorkspaceMember, error) {
+	if _, err := s.GetWorkspace(ctx, workspaceId); err != nil {
+		return nil, err
+	}
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT `+workspaceMemberColumns+` FROM workspace_members WHERE workspace_id = ? ORDER BY user_id`,
+		workspaceId,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query workspace members: %w", err)
+	}
+	defer rows.Close()
+	out := make([]model.WorkspaceMember, 0)
+	for rows.Next() {
+		member, err := scanWorkspaceMember(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan workspace member: %w", err)
+		}
+		out = append(out, *member)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan workspace members: %w", err)
+	}
+	return out, nil
+}
+
+func (s *sqlModel) SetWorkspaceMember(ctx context.Context, workspaceId string, userId string, params model.SetWorkspaceMemberParams) (*model.WorkspaceMember, error) {
+	if workspaceId == model.SharedWorkspaceId {
+		return nil, model.ErrBadRequest("the public workspace does not have members")
+	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	if _, err := s.GetWorkspace(ctx, workspaceId); err != nil {
+		return nil, err
+	}
+	if _, err := s.GetUser(ctx, userId); err != nil {
+		return nil, err
+	}
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	if params.Role != model.RoleOwner {
+		if err := checkNotLastOwner(ctx, tx, workspaceId, userId); err != nil {
+			return nil, err
+		}
+	}
+	member := model.WorkspaceMember{WorkspaceId: workspaceId, UserId: userId, AddedAt: time.Now().UTC(), Role: params.Role, GroupRoles: params.GroupRoles}
+	if err := setWorkspaceMember(ctx, tx, s.dialect, member); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit workspace member: %w", err)
+	}
+	return s.GetWorkspaceMember(ctx, workspaceId, userId)
+}
+
+// checkNotLastOwner returns an ErrBadRequest if the user is the only owner of the workspace, since nobody would be
+// left to manage it. The workspace is locked until the transaction ends so that concurrent changes to the members
+// cannot each leave the other as the only owner.
+func checkNotLastOwner(ctx context.Context, q queryer, workspaceId string, userId string) error {
+	if _, err := q.ExecContext(ctx, `UPDATE workspaces SET epoch = epoch WHERE id = ?`, workspaceId); err != nil {
+		return fmt.Errorf("failed to lock workspace: %w", err)
+	}
+	rows, err := q.QueryContext(
+		ctx, `SELECT user_id FROM workspace_members WHERE workspace_id = ? AND role = ?`, workspaceId, model.RoleOwner,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to query workspace owners: %w", err)
+	}
+	defer rows.Close()
+	isOwner, others := false, 0
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return fmt.Errorf("failed to scan workspace owner: %w", err)
+		}
+		if id == userId {
+			isOwner = true
+		} else {
+			others++
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to scan workspace owners: %w", err)
+	}
+	if isOwner && others == 0 {
+		return model.ErrBadRequest("the last owner of a workspace cannot be removed or given another role")
+	}
+	return nil
+}
+
+// setWorkspaceMember inserts the membership, or replaces the roles of the member if the user is already a member.
+func setWorkspaceMember(ctx context.Context, q queryer, d *dialect, member model.WorkspaceMember) error {
+	var groupRoles sql.NullString
+	if len(member.GroupRoles) > 0 {
+		raw, err := json.Marshal(member.GroupRoles)
+		if err != nil {
+			return fmt.Errorf("failed to marshal group roles: %w", err)
+		}
+		groupRoles = sql.NullString{String: string(raw), Valid: true}
+	}
+	if _, err := insertIfAbsent(
+		ctx, q, d,
+		`INSERT INTO workspace_members (workspace_id, user_id, added_at, role, group_roles) VALUES (?, ?, ?, ?, ?)`,
+		member.WorkspaceId, member.UserId, member.AddedAt, member.Role, groupRoles,
+	); err != nil {
+		return fmt.Errorf("failed to insert workspace member: %w", err)
+	}
+	if _, err := q.ExecContext(
+		ctx,
+		`UPDATE workspace_members SET role = ?, group_roles = ? WHERE workspace_id = ? AND user_id = ?`,
+		member.Role, groupRoles, member.WorkspaceId, member.UserId,
+	); err != nil {
+		return fmt.Errorf("failed to update workspace member: %w", err)
+	}
+	return nil
+}
+
+func (s *sqlModel) RemoveWorkspaceMember(ctx context.Context, workspaceId string, userId string) ([]model.Todo, error) {
+	if _, err := s.GetWorkspace(ctx, workspaceId); err != nil {
+		return nil, err
+	}
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err := checkNotLastOwner(ctx, tx, workspaceId, userId); err != nil {
+		return nil, err
+	}
+	if res, err := tx.ExecContext(
+		ctx, `DELETE FROM workspace_members WHERE workspace_id = ? AND user_id = ?`, workspaceId, userId,
+	); err != nil {
+		return nil, fmt.Errorf("failed to delete workspace member: %w", err)
+	} else if count, _ := res.RowsAffected(); count == 0 {
+		return nil, model.ErrNotFound(fmt.Sprintf("user '%s' is not a member of the workspace", userId))
+	}
+
+	// the watchers condition only narrows the candidates, the todos are checked again once they are scanned
+	rows, err := tx.QueryContext(
+		ctx,
+		`SELECT `+todoColumns+` FROM todos WHERE workspace_id = ? AND (assignee_id = ? OR watchers LIKE ?) ORDER BY group_id, id`,
+		workspaceId, userId, `%"`+userId+`"%`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query todos: %w", err)
+	}
+	defer rows.Close()
+	candidates := make([]model.Todo, 0)
+	for rows.Next() {
+		todo, err := scanTodo(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan todo: %w", err)
+		}
+		candidates = append(candidates, *todo)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan todos: %w", err)
+	}
+	// the rows must be closed before the transaction can run another statement
+	_ = rows.Close()
+
+	changed := make([]model.Todo, 0, len(candidates))
+	for _, before := range candidates {
+		todo := before
+		if !model.RemovePerson(&todo, userId) {
+			continue
+		}
+		watchers, err := encodeWatchers(todo.Watchers)
+		if err != nil {
+			return nil, err
+		}
+		todo.Revision, todo.RevisionAt = todo.Revision+1, time.Now().UTC()
+		if res, err := tx.ExecContext(
+			ctx,
+			`UPDATE todos SET assignee_id = ?, watchers = ?, revision = revision + 1, revision_at = ?
+			WHERE workspace_id = ? AND group_id = ? AND id = ? AND revision = ?`,
+			ref.DeRefToNullString(todo.Assignee), watchers, todo.RevisionAt, workspaceId, todo.Group.Id, todo.Id, before.Revision,
+		); err != nil {
+			return nil, fmt.Errorf("failed to update todo: %w", err)
+		} else if count, _ := res.RowsAffected(); count == 0 {
+			return nil, model.ErrConflict("todo was modified concurrently")
+		}
+		if err := insertRevision(ctx, tx, &todo, model.RevisionActionUpdated, model.DiffTodo(&before, &todo)); err != nil {
+			return nil, err
+		}
+		changed = append(changed, todo)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit member removal: %w", err)
+	}
+	return changed, nil
+}
+
+// checkPeople returns an ErrBadRequest unless every user is a member of the workspace, or any existing user in the
+// public workspace which has no members.
+func checkPeople(ctx context.Context, q queryer, workspaceId string, userIds []string) error {
+	if len(userIds) == 0 {
+		return nil
+	}
+	condition, args := inCondition("user_id", userIds)
+	query, args := `SELECT user_id FROM workspace_members WHERE workspace_id = ? AND `+condition, append([]interface{}{workspaceId}, args...)
+	if workspaceId == model.SharedWorkspaceId {
+		condition, args = inCondition("id", userIds)
+		query = `SELECT id FROM users WHERE ` + condition
+	}
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to query workspace members: %w", err)
+	}
+	defer rows.Close()
+	found := make(map[string]bool, len(userIds))
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return fmt.Errorf("failed to scan workspace member: %w", err)
+		}
+		found[id] = true
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to scan workspace members: %w", err)
+	}
+	for _, id := range userIds {
+		if !found[id] {
+			return model.ErrBadRequest(fmt.Sprintf("user '%s' is not a member of the workspace", id))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package sqlmodel
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/astromechza/todo-app/backend/model"
+)
+
+// storedWorkflow is the json representation of a model.Workflow in the workspaces table.
+type storedWorkflow struct {
+	InitialStatus string              `json:"initial"`
+	Statuses      []string            `json:"statuses"`
+	Transitions   map[string][]string `json:"transitions"`
+}
+
+func (s *sqlModel) GetWorkflow(ctx context.Context, workspaceId string) (*model.Workflow, error) {
+	var raw sql.NullString
+	if err := s.db.QueryRowContext(
+		ctx, `SELECT workflow FROM workspaces WHERE id = ?`, workspaceId,
+	).Scan(&raw); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrNotFound(fmt.Sprintf("workspace '%s' not found", workspaceId))
+		}
+		return nil, fmt.Errorf("failed to query and scan workflow: %w", err)
+	}
+	if !raw.Valid {
+		out := model.DefaultWorkflow
+		return &out, nil
+	}
+	var stored storedWorkflow
+	if err := json.Unmarshal([]byte(raw.String), &stored); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal workflow: %w", err)
+	}
+	return &model.Workflow{
+		InitialStatus: stored.InitialStatus,
+		Statuses:      stored.Statuses,
+		Transitions:   stored.Transitions,
+	}, nil
+}
+
+func (s *sqlModel) SetWorkflow(ctx context.Context, workspaceId string, workflow model.Workflow) (*model.Workflow, error) {
+	if err := workflow.Validate(); err != nil {
+		return nil, err
+	}
+	raw, err := json.Marshal(storedWorkflow{
+		InitialStatus: workflow.InitialStatus,
+		Statuses:      workflow.Statuses,
+		Transitions:   workflow.Transitions,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal workflow: %w", err)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if res, err := tx.ExecContext(ctx, `UPDATE workspaces SET workflow = ? WHERE id = ?`, string(raw), workspaceId); err != nil {
+		return nil, fmt.Errorf("failed to update workflow: %w", err)
+	} else if count, _ := res.RowsAffected(); count == 0 {
+		return nil, model.ErrNotFound(fmt.Sprintf("workspace '%s' not found", workspaceId))
+	}
+
+	// existing todos must not be left in a status that the new workflow does not know about
+	rows, err := tx.QueryContext(ctx, `SELECT DISTINCT status FROM todos WHERE workspace_id = ?`, workspaceId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query todo statuses: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var status string
+		if err := rows.Scan(&status); err != nil {
+			return nil, fmt.Errorf("failed to scan todo status: %w", err)
+		}
+		if !workflow.HasStatus(status) {
+			return nil, model.ErrConflict(fmt.Sprintf("todos in the workspace still have status '%s' which the workflow does not define", status))
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan todo statuses: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit workflow: %w", err)
+	}
+	return &workflow, nil
+}
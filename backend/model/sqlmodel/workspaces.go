@@ -0,0 +1,147 @@
+package sqlmodel
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/astromechza/todo-app/backend/model"
+	"github.com/astromechza/todo-app/pkg/ref"
+)
+
+func (s *sqlModel) GetWorkspace(ctx context.Context, id string) (*model.Workspace, error) {
+	var out model.Workspace
+	if err := s.db.QueryRowContext(
+		ctx,
+		`SELECT id, epoch, epoch_at, display_name FROM workspaces WHERE id = ?`,
+		id,
+	).Scan(&out.Id, &out.Epoch, &out.EpochAt, &out.DisplayName); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrNotFound(fmt.Sprintf("workspace '%s' not found", id))
+		}
+		return nil, fmt.Errorf("failed to query and scan workspace: %w", err)
+	}
+	return &out, nil
+}
+
+func (s *sqlModel) ListWorkspaces(ctx context.Context, params model.ListWorkspacesParams) (*model.ListWorkspacesPage, error) {
+	var pageToken struct {
+		LastId string `json:"i"`
+	}
+
+	if err := s.pageTokens.Decode(params.PageToken, model.WorkspacesPageScope, &pageToken); err != nil {
+		return nil, err
+	}
+
+	limit, err := model.PageLimit(params.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// the visible condition is appended to both the page and the remaining count queries
+	visible := sqlExpr{}
+	if params.VisibleTo != nil {
+		visible = sqlExpr{
+			` AND (id = ? OR id IN (SELECT workspace_id FROM workspace_members WHERE user_id = ?))`,
+			[]interface{}{model.SharedWorkspaceId, *params.VisibleTo},
+		}
+	}
+
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT id, epoch, epoch_at, display_name FROM workspaces WHERE id > ?`+visible.sql+` ORDER BY id LIMIT ?`,
+		append(append([]interface{}{pageToken.LastId}, visible.args...), limit)...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query workspaces: %w", err)
+	}
+	defer rows.Close()
+	outRows := make([]model.Workspace, 0)
+	for rows.Next() {
+		var out model.Workspace
+		if err := rows.Scan(&out.Id, &out.Epoch, &out.EpochAt, &out.DisplayName); err != nil {
+			return nil, fmt.Errorf("failed to scan workspace: %w", err)
+		}
+		outRows = append(outRows, out)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan workspaces: %w", err)
+	}
+
+	if len(outRows) > 0 {
+		pageToken.LastId = outRows[len(outRows)-1].Id
+	}
+	var remaining int
+	if err := s.db.QueryRowContext(
+		ctx, `SELECT COUNT(*) FROM workspaces WHERE id > ?`+visible.sql, append([]interface{}{pageToken.LastId}, visible.args...)...,
+	).Scan(&remaining); err != nil {
+		return nil, fmt.Errorf("failed to query and scan remaining count: %w", err)
+	}
+
+	page := &model.ListWorkspacesPage{
+		Items:          outRows,
+		RemainingItems: remaining,
+	}
+	if len(outRows) > 0 && remaining > 0 {
+		if page.NextPageToken, err = s.pageTokens.Encode(model.WorkspacesPageScope, pageToken); err != nil {
+			return nil, err
+		}
+	}
+	return page, nil
+}
+
+func (s *sqlModel) CreateWorkspace(ctx context.Context, params model.CreateWorkspacesParams) (*model.Workspace, error) {
+	out := model.Workspace{
+		Id:          ref.DeRefOr(params.Id, model.NewWorkspaceId()),
+		Epoch:       rand.Int63(),
+		EpochAt:     time.Now().UTC(),
+		DisplayName: params.DisplayName,
+	}
+	if out.Id == model.SharedWorkspaceId {
+		return nil, model.ErrConflict("the public workspace already exists")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if inserted, err := insertIfAbsent(
+		ctx, tx, s.dialect,
+		`INSERT INTO workspaces (id, epoch, epoch_at, display_name) VALUES (?, ?, ?, ?)`,
+		out.Id, out.Epoch, out.EpochAt, out.DisplayName,
+	); err != nil {
+		return nil, fmt.Errorf("failed to insert workspace: %w", err)
+	} else if !inserted {
+		return nil, model.ErrConflict(fmt.Sprintf("workspace '%s' already exists", out.Id))
+	}
+	if params.MemberId != nil {
+		if _, err := getUser(ctx, tx, *params.MemberId); err != nil {
+			return nil, err
+		}
+		if err := setWorkspaceMember(ctx, tx, s.dialect, model.WorkspaceMember{WorkspaceId: out.Id, UserId: *params.MemberId, AddedAt: out.EpochAt, Role: model.RoleOwner}); err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit workspace: %w", err)
+	}
+	return &out, nil
+}
+
+func (s *sqlModel) DeleteWorkspace(ctx context.Context, id string) error {
+	if id == model.SharedWorkspaceId {
+		return model.ErrBadRequest("the public workspace cannot be deleted")
+	}
+	// groups and their todos are removed by the cascading foreign keys
+	if res, err := s.db.ExecContext(ctx, `DELETE FROM workspaces WHERE id = ?`, id); err != nil {
+		return fmt.Errorf("failed to delete workspace: %w", err)
+	} else if count, _ := res.RowsAffected(); count == 0 {
+		return model.ErrNotFound(fmt.Sprintf("workspace '%s' not found", id))
+	}
+	return nil
+}
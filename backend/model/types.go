@@ -2,6 +2,9 @@ package model
 
 import (
 	"context"
+	"fmt"
+	"math/rand"
+	"slices"
 	"strings"
 	"time"
 )
@@ -9,7 +12,6 @@ import (
 const SharedWorkspaceId = "public"
 const DefaultWorkspaceEpoch = 0
 const DefaultGroupId = "TODO"
-const DefaultGroupEpoch = 0
 
 func SplitGroupId(id string) (string, string) {
 	parts := strings.SplitN(id, "-", 2)
@@ -19,11 +21,72 @@ func SplitGroupId(id string) (string, string) {
 	return "", parts[0]
 }
 
+const workspaceIdAlphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+const generatedWorkspaceIdLength = 16
+
+// NewWorkspaceId generates a random workspace id that satisfies the api workspace id pattern.
+func NewWorkspaceId() string {
+	out := make([]byte, generatedWorkspaceIdLength)
+	for i := range out {
+		out[i] = workspaceIdAlphabet[rand.Intn(len(workspaceIdAlphabet))]
+	}
+	return string(out)
+}
+
 type EntityReference struct {
 	Id    string
 	Epoch int64
 }
 
+type Workspace struct {
+	Id          string
+	Epoch       int64
+	EpochAt     time.Time
+	DisplayName string
+}
+
+type ListWorkspacesParams struct {
+	// VisibleTo limits the workspaces to the public workspace and those the user is a member of. An empty user id
+	// lists only the public workspace.
+	VisibleTo *string
+	PageToken *string
+	PageSize  *int
+}
+
+type ListWorkspacesPage struct {
+	Items          []Workspace
+	RemainingItems int
+	NextPageToken  *string
+}
+
+type CreateWorkspacesParams struct {
+	Id          *string
+	DisplayName string
+	// MemberId is added as the first owner of the workspace when it is set.
+	MemberId *string
+}
+
+type Group struct {
+	Id          string
+	Epoch       int64
+	EpochAt     time.Time
+	Workspace   EntityReference
+	DisplayName *string
+	LastSerial  int64
+	TodoCount   int
+}
+
+type CreateGroupsParams struct {
+	Id          string
+	DisplayName *string
+	NextSerial  *int64
+}
+
+type UpdateGroupsParams struct {
+	DisplayName *string
+	NextSerial  *int64
+}
+
 type Todo struct {
 	Id         int64
 	Epoch      int64
@@ -36,11 +99,18 @@ type Todo struct {
 	Title   string
 	Status  string
 	Details *string
+	// Assignee is the id of the user that the todo is assigned to, if any.
+	Assignee *string
+	// Watchers are the sorted ids of the users that follow the changes to the todo.
+	Watchers []string
+
+	// DeletedAt is set when the todo has been moved to the trash.
+	DeletedAt *time.Time
 }
 
 type ListTodosParams struct {
-	ByGroup   []string
-	ByStatus  []string
+	TodoFilter
+	Sort      TodoSort
 	PageToken *string
 	PageSize  *int
 }
@@ -55,19 +125,141 @@ type CreateTodosParams struct {
 	GroupId string
 	Title   string
 	Details *string
+	// Id is the preferred id of the todo within its group, so that imported todos keep their ids. The next serial of
+	// the group is used instead if the id is already taken.
+	Id *int64
+	// Status overrides the initial status of the workflow.
+	Status *string
+	// Assignee and Watchers must be members of the workspace, or any user in the public workspace.
+	Assignee *string
+	Watchers []string
+}
+
+type UpdateTodosParams struct {
+	Revision int64
+	// Epoch makes the update conditional, when it is set a mismatched epoch or revision is reported as an
+	// ErrPreconditionFailed rather than an ErrConflict.
+	Epoch *int64
+	Title *string
+	// Details replaces the details of the todo, empty details clear them.
+	Details *string
+	Status  *string
+	// Assignee replaces the assignee of the todo, an empty assignee unassigns it.
+	Assignee *string
+	// Watchers replaces the watchers of the todo.
+	Watchers *[]string
 }
 
+// MaxTodoWatchers is the most users that can watch a single todo.
+const MaxTodoWatchers = 50
+
+// NormaliseWatchers sorts and de-duplicates a copy of the watchers of a todo and checks that there are not too many.
+func NormaliseWatchers(watchers []string) ([]string, error) {
+	out := normaliseValues(watchers)
+	if len(out) > MaxTodoWatchers {
+		return nil, ErrBadRequest(fmt.Sprintf("a todo can have at most %d watchers", MaxTodoWatchers))
+	}
+	return out, nil
+}
+
+// TodoPeople returns the ids of the assignee, unless it is empty, and the watchers, which must all be people that may
+// access the workspace of the todo.
+func TodoPeople(assignee *string, watchers []string) []string {
+	out := slices.Clone(watchers)
+	if assignee != nil && *assignee != "" {
+		out = append(out, *assignee)
+	}
+	return out
+}
+
+// RemovePerson unassigns the user from the todo and removes them from its watchers, and reports whether the todo
+// changed. It does not touch the revision of the todo.
+func RemovePerson(t *Todo, userId string) bool {
+	changed := false
+	if t.Assignee != nil && *t.Assignee == userId {
+		t.Assignee, changed = nil, true
+	}
+	if slices.Contains(t.Watchers, userId) {
+		t.Watchers, changed = normaliseValues(slices.DeleteFunc(slices.Clone(t.Watchers), func(id string) bool {
+			return id == userId
+		})), true
+	}
+	return changed
+}
+
+// DeleteTodosParams are the optional preconditions of a delete, a mismatch is reported as an ErrPreconditionFailed.
+// Deleted todos are moved to the trash from where they can be restored until they are purged.
 type DeleteTodosParams struct {
-	Epoch    *int
-	Revision *int
+	Epoch    *int64
+	Revision *int64
 }
 
 type Modelling interface {
 	HealthZ(ctx context.Context) error
 
+	GetWorkspace(ctx context.Context, id string) (*Workspace, error)
+	ListWorkspaces(ctx context.Context, params ListWorkspacesParams) (*ListWorkspacesPage, error)
+	CreateWorkspace(ctx context.Context, params CreateWorkspacesParams) (*Workspace, error)
+	DeleteWorkspace(ctx context.Context, id string) error
+	GetWorkflow(ctx context.Context, workspaceId string) (*Workflow, error)
+	SetWorkflow(ctx context.Context, workspaceId string, workflow Workflow) (*Workflow, error)
+
+	CreateUser(ctx context.Context, params CreateUsersParams) (*User, error)
+	GetUser(ctx context.Context, id string) (*User, error)
+	// GetUserByApiKey returns the user that owns the api key secret, or an ErrNotFound.
+	GetUserByApiKey(ctx context.Context, secret string) (*User, error)
+	// GetUserByIdentity returns the user linked to the OpenID Connect identity, or an ErrNotFound.
+	GetUserByIdentity(ctx context.Context, identity UserIdentity) (*User, error)
+	CreateApiKey(ctx context.Context, userId string, params CreateApiKeysParams) (*ApiKey, error)
+	ListApiKeys(ctx context.Context, userId string) ([]ApiKey, error)
+	DeleteApiKey(ctx context.Context, userId string, id string) error
+
+	// GetWorkspaceMember returns the membership of the user in the workspace, or an ErrNotFound if they are not a
+	// member.
+	GetWorkspaceMember(ctx context.Context, workspaceId string, userId string) (*WorkspaceMember, error)
+	ListWorkspaceMembers(ctx context.Context, workspaceId string) ([]WorkspaceMember, error)
+	// SetWorkspaceMember adds the user as a member of the workspace or replaces the roles of an existing member. It
+	// returns an ErrBadRequest rather than give the last owner of the workspace another role.
+	SetWorkspaceMember(ctx context.Context, workspaceId string, userId string, params SetWorkspaceMemberParams) (*WorkspaceMember, error)
+	// RemoveWorkspaceMember removes the user from the workspace, unassigns them from its todos and removes them from
+	// their watchers, and returns the todos that changed sorted by group and id. The last owner cannot be removed.
+	RemoveWorkspaceMember(ctx context.Context, workspaceId string, userId string) ([]Todo, error)
+
+	CreateServiceAccount(ctx context.Context, workspaceId string, params CreateServiceAccountsParams) (*ServiceAccount, error)
+	ListServiceAccounts(ctx context.Context, workspaceId string) ([]ServiceAccount, error)
+	// DeleteServiceAccount deletes the service account along with its tokens.
+	DeleteServiceAccount(ctx context.Context, workspaceId string, id string) error
+	CreateServiceAccountToken(ctx context.Context, workspaceId string, accountId string, params CreateServiceAccountTokensParams) (*ServiceAccountToken, error)
+	// ListServiceAccountTokens returns every token of the service account, including the revoked and expired tokens.
+	ListServiceAccountTokens(ctx context.Context, workspaceId string, accountId string) ([]ServiceAccountToken, error)
+	// RevokeServiceAccountToken prevents any further use of the token. Revoking a revoked token has no effect.
+	RevokeServiceAccountToken(ctx context.Context, workspaceId string, accountId string, id string) error
+	// UseServiceAccountToken returns the token with the secret and records that it was used at the given time. It
+	// returns an ErrNotFound if there is no such token or it has been revoked or has expired.
+	UseServiceAccountToken(ctx context.Context, secret string, at time.Time) (*ServiceAccountToken, error)
+
+	GetGroup(ctx context.Context, workspaceId string, id string) (*Group, error)
+	ListGroups(ctx context.Context, workspaceId string) ([]Group, error)
+	CreateGroup(ctx context.Context, workspaceId string, params CreateGroupsParams) (*Group, error)
+	UpdateGroup(ctx context.Context, workspaceId string, id string, params UpdateGroupsParams) (*Group, error)
+	// DeleteGroup permanently deletes the group along with its todos and returns the todos that were active.
+	DeleteGroup(ctx context.Context, workspaceId string, id string) ([]Todo, error)
+
 	GetTodo(ctx context.Context, workspaceId string, id string) (*Todo, error)
 	ListTodos(ctx context.Context, workspaceId string, params ListTodosParams) (*ListTodosPage, error)
 	CreateTodo(ctx context.Context, workspaceId string, params CreateTodosParams) (*Todo, error)
-	DeleteTodo(ctx context.Context, workspaceId string, id string, params DeleteTodosParams) error
+	UpdateTodo(ctx context.Context, workspaceId string, id string, params UpdateTodosParams) (*Todo, error)
+	// DeleteTodo moves the todo to the trash and returns the trashed todo.
+	DeleteTodo(ctx context.Context, workspaceId string, id string, params DeleteTodosParams) (*Todo, error)
+	// BatchTodos applies the operations in order within a single transaction and returns a result for each of them.
+	// Unless the batch is partial, the first operation to fail is returned as a BatchError and nothing is applied. A
+	// partial batch is aborted in the same way by an error that IsOperationError does not report.
+	BatchTodos(ctx context.Context, workspaceId string, params BatchTodosParams) ([]TodoOperationResult, error)
+	RestoreTodo(ctx context.Context, workspaceId string, id string) (*Todo, error)
+	// ListTodoHistory returns the revisions of an active or trashed todo, oldest first.
+	ListTodoHistory(ctx context.Context, workspaceId string, id string) ([]TodoRevision, error)
+	// PurgeTrash permanently removes the todos in every workspace that were deleted before the given time and returns
+	// the number removed.
+	PurgeTrash(ctx context.Context, before time.Time) (int, error)
 	Close(ctx context.Context) error
 }
@@ -0,0 +1,139 @@
+package model
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ApiKeyPrefix starts every api key secret so that keys can be told apart from bearer tokens and found by scanners.
+const ApiKeyPrefix = "tdk_"
+
+type User struct {
+	Id          string
+	EpochAt     time.Time
+	DisplayName string
+}
+
+type CreateUsersParams struct {
+	Id          *string
+	DisplayName string
+	// Identity links the new user to an account at an OpenID Connect provider, so that they can sign in with it.
+	Identity *UserIdentity
+}
+
+// UserIdentity is an account at an OpenID Connect provider, identified by the issuer and the subject of its id tokens.
+type UserIdentity struct {
+	Issuer  string
+	Subject string
+}
+
+// ApiKey is a long-lived credential of a user. Only the hash of the secret is stored, the Secret is only set on the
+// key returned when it is created.
+type ApiKey struct {
+	Id        string
+	UserId    string
+	Name      string
+	CreatedAt time.Time
+	Secret    string
+}
+
+type CreateApiKeysParams struct {
+	Name string
+}
+
+// NewUserId generates a random user id in the same format as the generated workspace ids.
+func NewUserId() string {
+	return NewWorkspaceId()
+}
+
+// NewApiKey generates the id and secret of a new api key for the user.
+func NewApiKey(userId string, params CreateApiKeysParams) ApiKey {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		panic(err)
+	}
+	return ApiKey{
+		Id:        NewWorkspaceId(),
+		UserId:    userId,
+		Name:      params.Name,
+		CreatedAt: time.Now().UTC(),
+		Secret:    ApiKeyPrefix + base64.RawURLEncoding.EncodeToString(raw),
+	}
+}
+
+// HashApiKey returns the hash of an api key or service account token secret that is stored and looked up in place of
+// the secret. The secrets are random enough that an unsalted hash is sufficient.
+func HashApiKey(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsApiKey reports whether the credential looks like an api key secret rather than a bearer token.
+func IsApiKey(credential string) bool {
+	return strings.HasPrefix(credential, ApiKeyPrefix)
+}
+
+// The roles of a workspace member, each role is allowed everything that the roles before it are allowed.
+const (
+	// RoleViewer may read the workspace and its todos.
+	RoleViewer = "viewer"
+	// RoleCommenter may read the workspace, comments are not supported yet so it is otherwise the same as a viewer.
+	RoleCommenter = "commenter"
+	// RoleEditor may create, change and delete the todos and groups of the workspace.
+	RoleEditor = "editor"
+	// RoleOwner may also change the workflow and members of the workspace and delete it.
+	RoleOwner = "owner"
+)
+
+// Roles lists the member roles from the least to the most privileged.
+var Roles = []string{RoleViewer, RoleCommenter, RoleEditor, RoleOwner}
+
+// RoleAllows reports whether the role is allowed everything that the required role is allowed. An unknown role allows
+// nothing.
+func RoleAllows(role string, required string) bool {
+	rank := slices.Index(Roles, role)
+	return rank >= 0 && rank >= slices.Index(Roles, required)
+}
+
+// WorkspaceMember grants a user access to a workspace. Every user has access to the public workspace without being
+// a member of it.
+type WorkspaceMember struct {
+	WorkspaceId string
+	UserId      string
+	AddedAt     time.Time
+	Role        string
+	// GroupRoles are roles in individual groups of the workspace, keyed by group id. They can only grant more access
+	// within the group than the workspace Role.
+	GroupRoles map[string]string
+}
+
+// RoleIn returns the effective role of the member in the group, or in the workspace as a whole when the group is empty.
+func (m *WorkspaceMember) RoleIn(groupId string) string {
+	if role, ok := m.GroupRoles[groupId]; ok && groupId != "" && RoleAllows(role, m.Role) {
+		return role
+	}
+	return m.Role
+}
+
+type SetWorkspaceMemberParams struct {
+	Role       string
+	GroupRoles map[string]string
+}
+
+func (p *SetWorkspaceMemberParams) Validate() error {
+	if !slices.Contains(Roles, p.Role) {
+		return ErrBadRequest(fmt.Sprintf("unknown role '%s'", p.Role))
+	}
+	for groupId, role := range p.GroupRoles {
+		if !slices.Contains(Roles, role) {
+			return ErrBadRequest(fmt.Sprintf("unknown role '%s' for group '%s'", role, groupId))
+		}
+	}
+	return nil
+}
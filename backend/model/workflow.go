@@ -0,0 +1,94 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+)
+
+// Workflow defines the statuses a todo in a workspace may have and which status changes are allowed.
+type Workflow struct {
+	InitialStatus string
+	Statuses      []string
+	Transitions   map[string][]string
+}
+
+// DefaultWorkflow is used by any workspace that has not defined its own workflow.
+var DefaultWorkflow = Workflow{
+	InitialStatus: "open",
+	Statuses:      []string{"open", "in_progress", "done"},
+	Transitions: map[string][]string{
+		"open":        {"in_progress", "done"},
+		"in_progress": {"open", "done"},
+		"done":        {"open"},
+	},
+}
+
+var statusPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,31}$`)
+
+// Validate checks that the workflow is self-consistent and returns an ErrBadRequest if it is not.
+func (w *Workflow) Validate() error {
+	if len(w.Statuses) == 0 {
+		return ErrBadRequest("workflow must define at least one status")
+	}
+	seen := make(map[string]bool, len(w.Statuses))
+	for _, status := range w.Statuses {
+		if !statusPattern.MatchString(status) {
+			return ErrBadRequest(fmt.Sprintf("workflow status '%s' does not match %s", status, statusPattern.String()))
+		} else if seen[status] {
+			return ErrBadRequest(fmt.Sprintf("workflow status '%s' is defined more than once", status))
+		}
+		seen[status] = true
+	}
+	if !seen[w.InitialStatus] {
+		return ErrBadRequest(fmt.Sprintf("workflow initial status '%s' is not one of the defined statuses", w.InitialStatus))
+	}
+	for from, targets := range w.Transitions {
+		if !seen[from] {
+			return ErrBadRequest(fmt.Sprintf("workflow transition from undefined status '%s'", from))
+		}
+		for _, to := range targets {
+			if !seen[to] {
+				return ErrBadRequest(fmt.Sprintf("workflow transition from '%s' to undefined status '%s'", from, to))
+			}
+		}
+	}
+	return nil
+}
+
+// HasStatus returns whether the status is defined by the workflow.
+func (w *Workflow) HasStatus(status string) bool {
+	return slices.Contains(w.Statuses, status)
+}
+
+// TerminalStatus returns the status of a finished todo, which is the last status of the workflow. A workflow whose last
+// status is its initial status has no terminal status.
+func (w *Workflow) TerminalStatus() (string, bool) {
+	if len(w.Statuses) == 0 || w.Statuses[len(w.Statuses)-1] == w.InitialStatus {
+		return "", false
+	}
+	return w.Statuses[len(w.Statuses)-1], true
+}
+
+// InitialStatusOr returns the requested status of a new todo if it is defined by the workflow, or the initial status
+// of the workflow if none was requested.
+func (w *Workflow) InitialStatusOr(status *string) (string, error) {
+	if status == nil {
+		return w.InitialStatus, nil
+	} else if !w.HasStatus(*status) {
+		return "", ErrBadRequest(fmt.Sprintf("status '%s' is not defined by the workspace workflow", *status))
+	}
+	return *status, nil
+}
+
+// CheckTransition returns an ErrBadRequest if the target status is not defined by the workflow, or an ErrConflict if
+// the workflow does not allow a todo to move from the current status to the target status.
+func (w *Workflow) CheckTransition(from, to string) error {
+	if !w.HasStatus(to) {
+		return ErrBadRequest(fmt.Sprintf("status '%s' is not defined by the workspace workflow", to))
+	}
+	if from == to || slices.Contains(w.Transitions[from], to) {
+		return nil
+	}
+	return ErrConflict(fmt.Sprintf("the workspace workflow does not allow a transition from '%s' to '%s'", from, to))
+}
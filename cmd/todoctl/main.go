@@ -0,0 +1,251 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/astromechza/todo-app/pkg/client"
+)
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+	if err := mainInner(ctx, os.Args[1:], os.Stdout); err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			_, _ = fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		}
+		os.Exit(1)
+	}
+}
+
+const usage = `todoctl is a command line client for the Todo-App API.
+
+Usage:
+  todoctl [global flags] <command> [flags] [args]
+
+Commands:
+  list [--status S] [--group G] [--assignee me|none|U] [--query Q] [--sort F [--desc]]
+                            List, filter, or search the TODOs in the workspace
+  get <id>                  Get a TODO by id
+  create [--group G] [--assignee U] [--watcher U] <title>
+                            Create a TODO
+  update <id> [--status S] [--assignee U|none]
+                            Update the title, details, status, or assignee of a TODO
+  delete <id>               Delete a TODO by id, moving it to the trash
+  history <id>              Show every revision of a TODO
+  trash                     List the deleted TODOs in the trash
+  restore <id>              Restore a deleted TODO from the trash
+  export [--format F]       Write every TODO as jsonl, csv, or markdown
+  import [--format F] <file|->
+                            Create the TODOs in a jsonl, csv, or markdown file
+  workspaces list|get|create|delete
+                            Manage workspaces
+  members list|set|remove   Manage the members of the workspace and their roles
+  users create|me           Register a user or show the current user
+  service-accounts list|create|delete
+                            Manage the service accounts of the workspace
+  tokens list|create|revoke <account>
+                            Manage the tokens of a service account
+
+Global flags may also be given after the command name.
+
+Global flags:
+`
+
+// config is the resolved client configuration. Values come from flags, then environment variables, then the config
+// file, then the defaults.
+type config struct {
+	Server    string `yaml:"server"`
+	Workspace string `yaml:"workspace"`
+	Output    string `yaml:"output"`
+	// Token is the api key or bearer token sent with every request.
+	Token string `yaml:"token"`
+}
+
+type globalFlags struct {
+	server     string
+	workspace  string
+	output     string
+	token      string
+	configPath string
+}
+
+func (g *globalFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&g.server, "server", g.server, "the base url of the api server [$TODOCTL_SERVER]")
+	fs.StringVar(&g.workspace, "workspace", g.workspace, "the workspace id [$TODOCTL_WORKSPACE]")
+	fs.StringVar(&g.output, "output", g.output, "the output format: table, json, or yaml")
+	fs.StringVar(&g.output, "o", g.output, "shorthand for --output")
+	fs.StringVar(&g.token, "token", g.token, "the api key or bearer token to authenticate with [$TODOCTL_TOKEN]")
+	fs.StringVar(&g.configPath, "config", g.configPath, "the path to the config file [$TODOCTL_CONFIG]")
+}
+
+func defaultConfigPath() string {
+	if v := os.Getenv("TODOCTL_CONFIG"); v != "" {
+		return v
+	}
+	if dir, err := os.UserConfigDir(); err == nil {
+		return filepath.Join(dir, "todoctl", "config.yaml")
+	}
+	return ""
+}
+
+func (g *globalFlags) resolve() (*config, error) {
+	out := &config{Server: "http://localhost:8080", Workspace: "public", Output: "table"}
+	if g.configPath != "" {
+		if raw, err := os.ReadFile(g.configPath); err != nil {
+			if !errors.Is(err, os.ErrNotExist) {
+				return nil, fmt.Errorf("failed to read config file: %w", err)
+			}
+		} else {
+			var fromFile config
+			if err := yaml.Unmarshal(raw, &fromFile); err != nil {
+				return nil, fmt.Errorf("failed to parse config file '%s': %w", g.configPath, err)
+			}
+			out.merge(fromFile)
+		}
+	}
+	out.merge(config{Server: os.Getenv("TODOCTL_SERVER"), Workspace: os.Getenv("TODOCTL_WORKSPACE"), Token: os.Getenv("TODOCTL_TOKEN")})
+	out.merge(config{Server: g.server, Workspace: g.workspace, Output: g.output, Token: g.token})
+	switch out.Output {
+	case "table", "json", "yaml":
+	default:
+		return nil, fmt.Errorf("unknown output format '%s', expected table, json, or yaml", out.Output)
+	}
+	return out, nil
+}
+
+func (c *config) merge(other config) {
+	if other.Server != "" {
+		c.Server = other.Server
+	}
+	if other.Workspace != "" {
+		c.Workspace = other.Workspace
+	}
+	if other.Output != "" {
+		c.Output = other.Output
+	}
+	if other.Token != "" {
+		c.Token = other.Token
+	}
+}
+
+// command carries the global flags into each subcommand. The subcommand registers its own flags and then calls
+// parse to resolve the config and build the api client.
+type command struct {
+	globals *globalFlags
+	cfg     *config
+	client  *client.Client
+	out     io.Writer
+}
+
+func (c *command) parse(fs *flag.FlagSet, args []string) ([]string, error) {
+	c.globals.register(fs)
+	// allow flags to be interspersed with the positional arguments
+	positional := make([]string, 0, len(args))
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		remaining := fs.Args()
+		if len(remaining) == 0 {
+			break
+		}
+		// the flag set consumes a "--" terminator, everything after it is positional even if it looks like a flag
+		if consumed := len(args) - len(remaining); consumed > 0 && args[consumed-1] == "--" {
+			positional = append(positional, remaining...)
+			break
+		}
+		positional, args = append(positional, remaining[0]), remaining[1:]
+	}
+	cfg, err := c.globals.resolve()
+	if err != nil {
+		return nil, err
+	}
+	var opts []client.ClientOption
+	if cfg.Token != "" {
+		opts = append(opts, client.WithBearerToken(cfg.Token))
+	}
+	if c.client, err = client.New(cfg.Server, opts...); err != nil {
+		return nil, fmt.Errorf("failed to build client: %w", err)
+	}
+	c.cfg = cfg
+	return positional, nil
+}
+
+var subcommands = map[string]func(ctx context.Context, cmd *command, fs *flag.FlagSet, args []string) error{
+	"list":              listTodos,
+	"get":               getTodo,
+	"create":            createTodo,
+	"update":            updateTodo,
+	"delete":            deleteTodo,
+	"history":           todoHistory,
+	"trash":             listTrash,
+	"restore":           restoreTodo,
+	"export":            exportTodos,
+	"import":            importTodos,
+	"workspaces list":   listWorkspaces,
+	"workspaces get":    getWorkspace,
+	"workspaces create": createWorkspace,
+	"workspaces delete": deleteWorkspace,
+	"members list":      listMembers,
+	"members set":       setMember,
+	"members remove":    removeMember,
+	"users create":      createUser,
+	"users me":          currentUser,
+
+	"service-accounts list":   listServiceAccounts,
+	"service-accounts create": createServiceAccount,
+	"service-accounts delete": deleteServiceAccount,
+	"tokens list":             listServiceTokens,
+	"tokens create":           createServiceToken,
+	"tokens revoke":           revokeServiceToken,
+}
+
+// subcommandGroups are the commands that take a subcommand, along with a description of their subcommands.
+var subcommandGroups = map[string]string{
+	"workspaces": "list, get, create, or delete",
+	"members":    "list, set, or remove",
+	"users":      "create or me",
+
+	"service-accounts": "list, create, or delete",
+	"tokens":           "list, create, or revoke",
+}
+
+func mainInner(ctx context.Context, args []string, out io.Writer) error {
+	globals := &globalFlags{configPath: defaultConfigPath()}
+	fs := flag.NewFlagSet("todoctl", flag.ContinueOnError)
+	fs.Usage = func() {
+		_, _ = fmt.Fprint(fs.Output(), usage)
+		fs.PrintDefaults()
+	}
+	globals.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return flag.ErrHelp
+	}
+
+	name, args := fs.Arg(0), fs.Args()[1:]
+	if group, ok := subcommandGroups[name]; ok {
+		if len(args) == 0 {
+			return fmt.Errorf("expected a %s subcommand: %s", name, group)
+		}
+		name, args = name+" "+args[0], args[1:]
+	}
+	run, ok := subcommands[name]
+	if !ok {
+		return fmt.Errorf("unknown command '%s', see --help", name)
+	}
+	return run(ctx, &command{globals: globals, out: out}, flag.NewFlagSet("todoctl "+name, flag.ContinueOnError), args)
+}
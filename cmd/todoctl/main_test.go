@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/astromechza/todo-app/backend/api"
+	"github.com/astromechza/todo-app/backend/auth"
+	"github.com/astromechza/todo-app/backend/model"
+	"github.com/astromechza/todo-app/backend/model/memmodel"
+	"github.com/astromechza/todo-app/pkg/client"
+)
+
+// isolate stops the environment and config file of the machine running the tests from leaking into the commands.
+func isolate(t *testing.T) {
+	t.Setenv("TODOCTL_CONFIG", filepath.Join(t.TempDir(), "missing.yaml"))
+	t.Setenv("TODOCTL_SERVER", "")
+	t.Setenv("TODOCTL_WORKSPACE", "")
+	t.Setenv("TODOCTL_TOKEN", "")
+}
+
+func newTestBackend(t *testing.T) *httptest.Server {
+	spec, err := os.ReadFile("../../backend/api.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	validator, err := api.BuildOpenApiValidator(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := echo.New()
+	e.HTTPErrorHandler = api.DefaultErrorHandler
+	e.JSONSerializer = new(api.DefaultJsonSerializer)
+	db := memmodel.NewMemModel(model.NewPageTokenSigner(model.RandomPageTokenKey(), model.DefaultPageTokenTTL))
+	tokens := auth.NewSigner(auth.RandomKey(), auth.DefaultTokenTTL)
+	e.Use(api.BuildAuthMiddleware(db, tokens))
+	e.Use(validator)
+	server := &api.Server{Database: db, Events: model.NewEventBroker(model.DefaultEventBufferSize), Tokens: tokens}
+	api.RegisterHandlers(e, api.NewStrictHandler(server, []api.StrictMiddlewareFunc{api.BuildRoleMiddleware()}))
+	out := httptest.NewServer(e)
+	t.Cleanup(out.Close)
+	return out
+}
+
+// run runs the command line and returns what it wrote.
+func run(t *testing.T, args ...string) string {
+	t.Helper()
+	var out bytes.Buffer
+	if err := mainInner(context.Background(), args, &out); err != nil {
+		t.Fatalf("todoctl %s: %v", strings.Join(args, " "), err)
+	}
+	return out.String()
+}
+
+func TestResolvePrecedence(t *testing.T) {
+	isolate(t)
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("server: http://file\nworkspace: fromfile\noutput: json\ntoken: file-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := (&globalFlags{}).resolve()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *cfg != (config{Server: "http://localhost:8080", Workspace: "public", Output: "table"}) {
+		t.Errorf("expected the defaults, got %+v", cfg)
+	}
+
+	cfg, err = (&globalFlags{configPath: path}).resolve()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *cfg != (config{Server: "http://file", Workspace: "fromfile", Output: "json", Token: "file-token"}) {
+		t.Errorf("expected the config file to override the defaults, got %+v", cfg)
+	}
+
+	t.Setenv("TODOCTL_SERVER", "http://env")
+	t.Setenv("TODOCTL_TOKEN", "env-token")
+	cfg, err = (&globalFlags{configPath: path}).resolve()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *cfg != (config{Server: "http://env", Workspace: "fromfile", Output: "json", Token: "env-token"}) {
+		t.Errorf("expected the environment to override the config file, got %+v", cfg)
+	}
+
+	cfg, err = (&globalFlags{configPath: path, server: "http://flag", output: "yaml"}).resolve()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *cfg != (config{Server: "http://flag", Workspace: "fromfile", Output: "yaml", Token: "env-token"}) {
+		t.Errorf("expected the flags to override the environment, got %+v", cfg)
+	}
+
+	if _, err := (&globalFlags{output: "xml"}).resolve(); err == nil {
+		t.Error("expected an unknown output format to be rejected")
+	}
+}
+
+func TestListTodos(t *testing.T) {
+	isolate(t)
+	server := newTestBackend(t).URL
+	for _, title := range []string{"First thing", "Second thing", "Third thing"} {
+		// the global flags may follow the positional arguments
+		run(t, "create", title, "--server", server)
+	}
+	// a "--" ends the flags, so the title is not parsed as the group flag
+	run(t, "create", "--server", server, "--", "--group title")
+	if out := run(t, "--server", server, "get", "TODO-4"); !strings.Contains(out, "--group title") {
+		t.Errorf("expected the title after the terminator to be kept, got %s", out)
+	}
+	var out bytes.Buffer
+	if err := mainInner(context.Background(), []string{"create", "--server", server, "--", "one", "--group", "OPS"}, &out); err == nil {
+		t.Error("expected the arguments after the terminator to be positional")
+	}
+
+	list := run(t, "--server", server, "list", "--page-size", "2")
+	if !strings.Contains(list, "First thing") || strings.Contains(list, "Third thing") || !strings.Contains(list, "2 more, use --page") {
+		t.Errorf("unexpected first page:\n%s", list)
+	}
+
+	var page client.TodoPage
+	if err := json.Unmarshal([]byte(run(t, "list", "--server", server, "--page-size", "2", "--all", "-o", "json")), &page); err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Items) != 4 || page.Items[0].Title != "First thing" || page.Items[3].Metadata.Id != "TODO-4" || page.NextPageToken != nil {
+		t.Errorf("expected every todo across the pages, got %+v", page)
+	}
+
+	list = run(t, "list", "--server", server, "--output", "yaml", "--status", "open")
+	if !strings.Contains(list, "title: Second thing") || !strings.Contains(list, "id: TODO-3") {
+		t.Errorf("unexpected yaml output:\n%s", list)
+	}
+}
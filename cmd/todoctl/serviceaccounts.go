@@ -0,0 +1,147 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/astromechza/todo-app/pkg/client"
+	"github.com/astromechza/todo-app/pkg/ref"
+)
+
+var serviceAccountHeader = []string{"ID", "NAME", "CREATED"}
+
+func serviceAccountRow(item client.ServiceAccount) []string {
+	return []string{item.Id, item.DisplayName, formatTime(item.CreatedAt)}
+}
+
+func listServiceAccounts(ctx context.Context, cmd *command, fs *flag.FlagSet, args []string) error {
+	if _, err := cmd.parse(fs, args); err != nil {
+		return err
+	}
+	out, err := cmd.client.ListServiceAccounts(ctx, cmd.cfg.Workspace)
+	if err != nil {
+		return err
+	}
+	rows := make([][]string, len(out))
+	for i, item := range out {
+		rows[i] = serviceAccountRow(item)
+	}
+	return cmd.print(out, serviceAccountHeader, rows)
+}
+
+func createServiceAccount(ctx context.Context, cmd *command, fs *flag.FlagSet, args []string) error {
+	args, err := cmd.parse(fs, args)
+	if err != nil {
+		return err
+	} else if len(args) != 1 {
+		return fmt.Errorf("expected exactly one display name argument")
+	}
+	item, err := cmd.client.CreateServiceAccount(ctx, cmd.cfg.Workspace, client.CreateServiceAccount{DisplayName: args[0]})
+	if err != nil {
+		return err
+	}
+	return cmd.print(item, serviceAccountHeader, [][]string{serviceAccountRow(*item)})
+}
+
+func deleteServiceAccount(ctx context.Context, cmd *command, fs *flag.FlagSet, args []string) error {
+	args, err := cmd.parse(fs, args)
+	if err != nil {
+		return err
+	} else if len(args) != 1 {
+		return fmt.Errorf("expected exactly one service account id argument")
+	}
+	if err := cmd.client.DeleteServiceAccount(ctx, cmd.cfg.Workspace, args[0]); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(cmd.out, "deleted service account %s\n", args[0])
+	return nil
+}
+
+var serviceTokenHeader = []string{"ID", "NAME", "SCOPES", "GROUPS", "EXPIRES", "LAST USED", "REVOKED"}
+
+func serviceTokenRow(item client.ServiceAccountToken) []string {
+	scopes := make([]string, len(item.Scopes))
+	for i, scope := range item.Scopes {
+		scopes[i] = string(scope)
+	}
+	optionalTime := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return formatTime(*t)
+	}
+	return []string{
+		item.Id, item.Name, strings.Join(scopes, ","), strings.Join(ref.DeRefOr(item.GroupIds, nil), ","),
+		formatTime(item.ExpiresAt), optionalTime(item.LastUsedAt), optionalTime(item.RevokedAt),
+	}
+}
+
+func listServiceTokens(ctx context.Context, cmd *command, fs *flag.FlagSet, args []string) error {
+	args, err := cmd.parse(fs, args)
+	if err != nil {
+		return err
+	} else if len(args) != 1 {
+		return fmt.Errorf("expected exactly one service account id argument")
+	}
+	out, err := cmd.client.ListServiceAccountTokens(ctx, cmd.cfg.Workspace, args[0])
+	if err != nil {
+		return err
+	}
+	rows := make([][]string, len(out))
+	for i, item := range out {
+		rows[i] = serviceTokenRow(item)
+	}
+	return cmd.print(out, serviceTokenHeader, rows)
+}
+
+func createServiceToken(ctx context.Context, cmd *command, fs *flag.FlagSet, args []string) error {
+	var scopes, groups string
+	var expires time.Duration
+	fs.StringVar(&scopes, "scopes", "todos:read,todos:write", "comma separated scopes to grant the token")
+	fs.StringVar(&groups, "groups", "", "comma separated group ids to limit the token to")
+	fs.DurationVar(&expires, "expires", 0, "how long until the token expires, the server default is 90 days")
+	args, err := cmd.parse(fs, args)
+	if err != nil {
+		return err
+	} else if len(args) != 2 {
+		return fmt.Errorf("expected a service account id and a token name argument")
+	}
+	body := client.CreateServiceAccountToken{Name: args[1]}
+	for _, scope := range strings.Split(scopes, ",") {
+		body.Scopes = append(body.Scopes, client.Scope(scope))
+	}
+	if groups != "" {
+		body.GroupIds = ref.Ref(strings.Split(groups, ","))
+	}
+	if expires > 0 {
+		body.ExpiresAt = ref.Ref(time.Now().Add(expires))
+	}
+	item, err := cmd.client.CreateServiceAccountToken(ctx, cmd.cfg.Workspace, args[0], body)
+	if err != nil {
+		return err
+	}
+	if err := cmd.print(item, serviceTokenHeader, [][]string{serviceTokenRow(*item)}); err != nil {
+		return err
+	}
+	if cmd.cfg.Output == "table" && item.Secret != nil {
+		_, _ = fmt.Fprintf(cmd.out, "\ntoken (shown only once): %s\n", *item.Secret)
+	}
+	return nil
+}
+
+func revokeServiceToken(ctx context.Context, cmd *command, fs *flag.FlagSet, args []string) error {
+	args, err := cmd.parse(fs, args)
+	if err != nil {
+		return err
+	} else if len(args) != 2 {
+		return fmt.Errorf("expected a service account id and a token id argument")
+	}
+	if err := cmd.client.RevokeServiceAccountToken(ctx, cmd.cfg.Workspace, args[0], args[1]); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(cmd.out, "revoked token %s\n", args[1])
+	return nil
+}
@@ -0,0 +1,356 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/astromechza/todo-app/pkg/client"
+	"github.com/astromechza/todo-app/pkg/ref"
+)
+
+var todoHeader = []string{"ID", "STATUS", "TITLE", "UPDATED"}
+
+func todoRow(item client.Todo) []string {
+	return []string{item.Metadata.Id, item.Status, item.Title, formatTime(item.Metadata.UpdatedAt)}
+}
+
+func (c *command) printTodo(item *client.Todo) error {
+	return c.print(item, todoHeader, [][]string{todoRow(*item)})
+}
+
+func listTodos(ctx context.Context, cmd *command, fs *flag.FlagSet, args []string) error {
+	var query, assignee, sort, page string
+	var desc bool
+	var pageSize int
+	var all bool
+	params := client.ListTodosParams{}
+	fs.Func("status", "only list TODOs with this status, may be repeated", appendValue(&params.Status))
+	fs.Func("group", "only list TODOs in this group, may be repeated", appendValue(&params.Group))
+	fs.Func("created-after", "only list TODOs created at or after this RFC3339 time", parseTime(&params.CreatedAfter))
+	fs.Func("created-before", "only list TODOs created before this RFC3339 time", parseTime(&params.CreatedBefore))
+	fs.Func("updated-after", "only list TODOs updated at or after this RFC3339 time", parseTime(&params.UpdatedAfter))
+	fs.Func("updated-before", "only list TODOs updated before this RFC3339 time", parseTime(&params.UpdatedBefore))
+	fs.StringVar(&assignee, "assignee", "", "only list TODOs assigned to this user id, 'me', or 'none' for unassigned TODOs")
+	fs.StringVar(&query, "query", "", "search the title and details, results are ordered by relevance")
+	fs.StringVar(&sort, "sort", "", "sort by created_at, updated_at, or title")
+	fs.BoolVar(&desc, "desc", false, "sort in descending order")
+	fs.StringVar(&page, "page", "", "the page token to start from")
+	fs.IntVar(&pageSize, "page-size", 0, "the number of TODOs to request per page")
+	fs.BoolVar(&all, "all", false, "follow the next page tokens and list every TODO")
+	if _, err := cmd.parse(fs, args); err != nil {
+		return err
+	}
+
+	if assignee != "" {
+		params.Assignee = &assignee
+	}
+	if query != "" {
+		params.Q = &query
+	}
+	if sort != "" {
+		params.Sort = ref.Ref(client.ListTodosParamsSort(sort))
+	}
+	if desc {
+		params.Order = ref.Ref(client.ListTodosParamsOrderDesc)
+	}
+	if page != "" {
+		params.Page = &page
+	}
+	if pageSize > 0 {
+		params.PageSize = &pageSize
+	}
+
+	var out *client.TodoPage
+	if all {
+		out = &client.TodoPage{Items: make([]client.Todo, 0)}
+		it := cmd.client.IterateTodos(cmd.cfg.Workspace, params)
+		for it.Next(ctx) {
+			out.Items = append(out.Items, it.Todo())
+		}
+		if err := it.Err(); err != nil {
+			return err
+		}
+	} else {
+		var err error
+		if out, err = cmd.client.ListTodos(ctx, cmd.cfg.Workspace, params); err != nil {
+			return err
+		}
+	}
+
+	rows := make([][]string, len(out.Items))
+	for i, item := range out.Items {
+		rows[i] = todoRow(item)
+	}
+	if err := cmd.print(out, todoHeader, rows); err != nil {
+		return err
+	}
+	if cmd.cfg.Output == "table" && out.NextPageToken != nil {
+		_, _ = fmt.Fprintf(cmd.out, "\n%d more, use --page %s or --all to see them\n", out.RemainingItems, *out.NextPageToken)
+	}
+	return nil
+}
+
+func getTodo(ctx context.Context, cmd *command, fs *flag.FlagSet, args []string) error {
+	args, err := cmd.parse(fs, args)
+	if err != nil {
+		return err
+	} else if len(args) != 1 {
+		return fmt.Errorf("expected exactly one TODO id argument")
+	}
+	item, err := cmd.client.GetTodo(ctx, cmd.cfg.Workspace, args[0])
+	if err != nil {
+		return err
+	}
+	return cmd.printTodo(item)
+}
+
+func createTodo(ctx context.Context, cmd *command, fs *flag.FlagSet, args []string) error {
+	var group, details, assignee string
+	var watchers *[]string
+	fs.StringVar(&group, "group", "", "the group to create the TODO in")
+	fs.StringVar(&details, "details", "", "the longer details of the TODO")
+	fs.StringVar(&assignee, "assignee", "", "the id of the user to assign the TODO to")
+	fs.Func("watcher", "the id of a user watching the TODO, may be repeated", appendValue(&watchers))
+	args, err := cmd.parse(fs, args)
+	if err != nil {
+		return err
+	} else if len(args) != 1 {
+		return fmt.Errorf("expected exactly one title argument")
+	}
+	body := client.CreateTodo{Title: args[0]}
+	if group != "" {
+		body.GroupId = &group
+	}
+	if details != "" {
+		body.Details = &details
+	}
+	if assignee != "" {
+		body.Assignee = &assignee
+	}
+	body.Watchers = watchers
+	item, err := cmd.client.CreateTodo(ctx, cmd.cfg.Workspace, body)
+	if err != nil {
+		return err
+	}
+	return cmd.printTodo(item)
+}
+
+func updateTodo(ctx context.Context, cmd *command, fs *flag.FlagSet, args []string) error {
+	var title, details, status, assignee, revision string
+	fs.StringVar(&title, "title", "", "the new title")
+	fs.StringVar(&details, "details", "", "the new details")
+	fs.StringVar(&status, "status", "", "the new status")
+	fs.StringVar(&assignee, "assignee", "", "the id of the user to assign the TODO to, or 'none' to unassign it")
+	fs.StringVar(&revision, "revision", "", "the expected current revision, defaults to the latest revision")
+	args, err := cmd.parse(fs, args)
+	if err != nil {
+		return err
+	} else if len(args) != 1 {
+		return fmt.Errorf("expected exactly one TODO id argument")
+	}
+
+	body := client.UpdateTodo{}
+	if revision != "" {
+		if body.Revision, err = strconv.Atoi(revision); err != nil {
+			return fmt.Errorf("invalid revision: %w", err)
+		}
+	} else if current, err := cmd.client.GetTodo(ctx, cmd.cfg.Workspace, args[0]); err != nil {
+		return err
+	} else {
+		body.Revision = current.Metadata.Revision
+	}
+	if title != "" {
+		body.Title = &title
+	}
+	if details != "" {
+		body.Details = &details
+	}
+	if status != "" {
+		body.Status = &status
+	}
+	if assignee == "none" {
+		body.Assignee = ref.Ref("")
+	} else if assignee != "" {
+		body.Assignee = &assignee
+	}
+	item, err := cmd.client.UpdateTodo(ctx, cmd.cfg.Workspace, args[0], client.UpdateTodoParams{}, body)
+	if err != nil {
+		return err
+	}
+	return cmd.printTodo(item)
+}
+
+func deleteTodo(ctx context.Context, cmd *command, fs *flag.FlagSet, args []string) error {
+	var ifMatch string
+	fs.StringVar(&ifMatch, "if-match", "", "only delete the TODO if it still has this ETag")
+	args, err := cmd.parse(fs, args)
+	if err != nil {
+		return err
+	} else if len(args) == 0 {
+		return fmt.Errorf("expected at least one TODO id argument")
+	} else if ifMatch != "" && len(args) != 1 {
+		return fmt.Errorf("--if-match can only be used when deleting a single TODO")
+	}
+	params := client.DeleteTodoParams{}
+	if ifMatch != "" {
+		params.IfMatch = &ifMatch
+	}
+	for _, id := range args {
+		if err := cmd.client.DeleteTodo(ctx, cmd.cfg.Workspace, id, params); err != nil {
+			return fmt.Errorf("failed to delete %s: %w", id, err)
+		}
+		_, _ = fmt.Fprintf(cmd.out, "deleted %s\n", id)
+	}
+	return nil
+}
+
+func listTrash(ctx context.Context, cmd *command, fs *flag.FlagSet, args []string) error {
+	var page string
+	var pageSize int
+	fs.StringVar(&page, "page", "", "the page token to start from")
+	fs.IntVar(&pageSize, "page-size", 0, "the number of TODOs to request per page")
+	if _, err := cmd.parse(fs, args); err != nil {
+		return err
+	}
+
+	params := client.ListTrashParams{}
+	if page != "" {
+		params.Page = &page
+	}
+	if pageSize > 0 {
+		params.PageSize = &pageSize
+	}
+	out, err := cmd.client.ListTrash(ctx, cmd.cfg.Workspace, params)
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, len(out.Items))
+	for i, item := range out.Items {
+		rows[i] = []string{item.Metadata.Id, item.Status, item.Title, ""}
+		if item.Metadata.DeletedAt != nil {
+			rows[i][3] = formatTime(*item.Metadata.DeletedAt)
+		}
+	}
+	if err := cmd.print(out, []string{"ID", "STATUS", "TITLE", "DELETED"}, rows); err != nil {
+		return err
+	}
+	if cmd.cfg.Output == "table" && out.NextPageToken != nil {
+		_, _ = fmt.Fprintf(cmd.out, "\n%d more, use --page %s to see them\n", out.RemainingItems, *out.NextPageToken)
+	}
+	return nil
+}
+
+func restoreTodo(ctx context.Context, cmd *command, fs *flag.FlagSet, args []string) error {
+	args, err := cmd.parse(fs, args)
+	if err != nil {
+		return err
+	} else if len(args) != 1 {
+		return fmt.Errorf("expected exactly one TODO id argument")
+	}
+	item, err := cmd.client.RestoreTodo(ctx, cmd.cfg.Workspace, args[0])
+	if err != nil {
+		return err
+	}
+	return cmd.printTodo(item)
+}
+
+func todoHistory(ctx context.Context, cmd *command, fs *flag.FlagSet, args []string) error {
+	args, err := cmd.parse(fs, args)
+	if err != nil {
+		return err
+	} else if len(args) != 1 {
+		return fmt.Errorf("expected exactly one TODO id argument")
+	}
+	out, err := cmd.client.GetTodoHistory(ctx, cmd.cfg.Workspace, args[0])
+	if err != nil {
+		return err
+	}
+	rows := make([][]string, len(out))
+	for i, item := range out {
+		changes := make([]string, len(item.Changes))
+		for j, c := range item.Changes {
+			changes[j] = fmt.Sprintf("%s: %q -> %q", c.Field, ref.DeRefOr(c.From, ""), ref.DeRefOr(c.To, ""))
+		}
+		rows[i] = []string{strconv.Itoa(item.Revision), string(item.Action), formatTime(item.UpdatedAt), strings.Join(changes, ", ")}
+	}
+	return cmd.print(out, []string{"REVISION", "ACTION", "UPDATED", "CHANGES"}, rows)
+}
+
+// appendValue returns a flag function that appends every occurrence of the flag to the list.
+func appendValue(into **[]string) func(string) error {
+	return func(value string) error {
+		if *into == nil {
+			*into = new([]string)
+		}
+		**into = append(**into, value)
+		return nil
+	}
+}
+
+// parseTime returns a flag function that parses an RFC3339 time.
+func parseTime(into **time.Time) func(string) error {
+	return func(value string) error {
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return err
+		}
+		*into = &t
+		return nil
+	}
+}
+
+func exportTodos(ctx context.Context, cmd *command, fs *flag.FlagSet, args []string) error {
+	format := fs.String("format", "jsonl", "the export format: jsonl, csv, or markdown")
+	if args, err := cmd.parse(fs, args); err != nil {
+		return err
+	} else if len(args) != 0 {
+		return fmt.Errorf("expected no arguments")
+	}
+	out, err := cmd.client.ExportTodos(ctx, cmd.cfg.Workspace, client.ExportTodosParams{Format: ref.Ref(client.ExportTodosParamsFormat(*format))})
+	if err != nil {
+		return err
+	}
+	_, err = cmd.out.Write(out)
+	return err
+}
+
+func importTodos(ctx context.Context, cmd *command, fs *flag.FlagSet, args []string) error {
+	format := fs.String("format", "jsonl", "the import format: jsonl, csv, or markdown")
+	args, err := cmd.parse(fs, args)
+	if err != nil {
+		return err
+	} else if len(args) != 1 {
+		return fmt.Errorf("expected exactly one file argument, or - for stdin")
+	}
+	var in io.Reader = os.Stdin
+	if args[0] != "-" {
+		f, err := os.Open(args[0])
+		if err != nil {
+			return fmt.Errorf("failed to open import file: %w", err)
+		}
+		defer f.Close()
+		in = f
+	}
+	out, err := cmd.client.ImportTodos(ctx, cmd.cfg.Workspace, client.ImportTodosParams{Format: ref.Ref(client.ImportTodosParamsFormat(*format))}, in)
+	if err != nil {
+		return err
+	}
+	rows := make([][]string, len(out.Renumbered))
+	for i, item := range out.Renumbered {
+		rows[i] = []string{item.From, item.To}
+	}
+	if err := cmd.print(out, []string{"FROM", "TO"}, rows); err != nil {
+		return err
+	}
+	if cmd.cfg.Output == "table" {
+		_, _ = fmt.Fprintf(cmd.out, "\nimported %d TODOs, %d renumbered\n", out.Imported, len(out.Renumbered))
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/astromechza/todo-app/pkg/client"
+	"github.com/astromechza/todo-app/pkg/ref"
+)
+
+var userHeader = []string{"ID", "NAME", "CREATED"}
+
+func userRow(item client.User) []string {
+	return []string{item.Id, item.DisplayName, formatTime(item.CreatedAt)}
+}
+
+func createUser(ctx context.Context, cmd *command, fs *flag.FlagSet, args []string) error {
+	var id string
+	fs.StringVar(&id, "id", "", "the id of the new user, generated if not set")
+	args, err := cmd.parse(fs, args)
+	if err != nil {
+		return err
+	} else if len(args) != 1 {
+		return fmt.Errorf("expected exactly one display name argument")
+	}
+	body := client.CreateUser{DisplayName: args[0]}
+	if id != "" {
+		body.Id = &id
+	}
+	out, err := cmd.client.CreateUser(ctx, body)
+	if err != nil {
+		return err
+	}
+	if err := cmd.print(out, userHeader, [][]string{userRow(out.User)}); err != nil {
+		return err
+	}
+	if cmd.cfg.Output == "table" && out.ApiKey.Secret != nil {
+		_, _ = fmt.Fprintf(cmd.out, "\napi key (shown only once): %s\n", *out.ApiKey.Secret)
+	}
+	return nil
+}
+
+func currentUser(ctx context.Context, cmd *command, fs *flag.FlagSet, args []string) error {
+	if _, err := cmd.parse(fs, args); err != nil {
+		return err
+	}
+	item, err := cmd.client.GetCurrentUser(ctx)
+	if err != nil {
+		return err
+	}
+	return cmd.print(item, userHeader, [][]string{userRow(*item)})
+}
+
+var memberHeader = []string{"USER", "ROLE", "GROUP ROLES", "ADDED"}
+
+func memberRow(item client.WorkspaceMember) []string {
+	groupRoles := make([]string, 0)
+	for groupId, role := range ref.DeRefOr(item.GroupRoles, nil) {
+		groupRoles = append(groupRoles, groupId+"="+string(role))
+	}
+	slices.Sort(groupRoles)
+	return []string{item.UserId, string(item.Role), strings.Join(groupRoles, ","), formatTime(item.AddedAt)}
+}
+
+func listMembers(ctx context.Context, cmd *command, fs *flag.FlagSet, args []string) error {
+	if _, err := cmd.parse(fs, args); err != nil {
+		return err
+	}
+	out, err := cmd.client.ListWorkspaceMembers(ctx, cmd.cfg.Workspace)
+	if err != nil {
+		return err
+	}
+	rows := make([][]string, len(out))
+	for i, item := range out {
+		rows[i] = memberRow(item)
+	}
+	return cmd.print(out, memberHeader, rows)
+}
+
+func setMember(ctx context.Context, cmd *command, fs *flag.FlagSet, args []string) error {
+	var role, groupRoles string
+	fs.StringVar(&role, "role", string(client.Editor), "the role of the member: viewer, commenter, editor, or owner")
+	fs.StringVar(&groupRoles, "group-roles", "", "comma separated GROUP=ROLE roles of the member in individual groups")
+	args, err := cmd.parse(fs, args)
+	if err != nil {
+		return err
+	} else if len(args) != 1 {
+		return fmt.Errorf("expected exactly one user id argument")
+	}
+	body := client.SetWorkspaceMember{Role: client.Role(role)}
+	if groupRoles != "" {
+		roles := make(client.GroupRoles)
+		for _, part := range strings.Split(groupRoles, ",") {
+			groupId, groupRole, ok := strings.Cut(part, "=")
+			if !ok {
+				return fmt.Errorf("invalid group role '%s', expected GROUP=ROLE", part)
+			}
+			roles[groupId] = client.Role(groupRole)
+		}
+		body.GroupRoles = &roles
+	}
+	item, err := cmd.client.SetWorkspaceMember(ctx, cmd.cfg.Workspace, args[0], body)
+	if err != nil {
+		return err
+	}
+	return cmd.print(item, memberHeader, [][]string{memberRow(*item)})
+}
+
+func removeMember(ctx context.Context, cmd *command, fs *flag.FlagSet, args []string) error {
+	args, err := cmd.parse(fs, args)
+	if err != nil {
+		return err
+	} else if len(args) != 1 {
+		return fmt.Errorf("expected exactly one user id argument")
+	}
+	if err := cmd.client.RemoveWorkspaceMember(ctx, cmd.cfg.Workspace, args[0]); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(cmd.out, "removed %s from workspace %s\n", args[0], cmd.cfg.Workspace)
+	return nil
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strconv"
+
+	"github.com/astromechza/todo-app/pkg/client"
+)
+
+var workspaceHeader = []string{"ID", "NAME", "EPOCH", "CREATED"}
+
+func workspaceRow(item client.Workspace) []string {
+	return []string{item.Metadata.Id, item.DisplayName, strconv.Itoa(item.Metadata.Epoch), formatTime(item.Metadata.CreatedAt)}
+}
+
+func listWorkspaces(ctx context.Context, cmd *command, fs *flag.FlagSet, args []string) error {
+	if _, err := cmd.parse(fs, args); err != nil {
+		return err
+	}
+	out := &client.WorkspacePage{Items: make([]client.Workspace, 0)}
+	params := client.ListWorkspacesParams{}
+	for {
+		page, err := cmd.client.ListWorkspaces(ctx, params)
+		if err != nil {
+			return err
+		}
+		out.Items = append(out.Items, page.Items...)
+		if params.Page = page.NextPageToken; params.Page == nil {
+			break
+		}
+	}
+	rows := make([][]string, len(out.Items))
+	for i, item := range out.Items {
+		rows[i] = workspaceRow(item)
+	}
+	return cmd.print(out, workspaceHeader, rows)
+}
+
+func getWorkspace(ctx context.Context, cmd *command, fs *flag.FlagSet, args []string) error {
+	args, err := cmd.parse(fs, args)
+	if err != nil {
+		return err
+	}
+	id := cmd.cfg.Workspace
+	if len(args) == 1 {
+		id = args[0]
+	} else if len(args) > 1 {
+		return fmt.Errorf("expected at most one workspace id argument")
+	}
+	item, err := cmd.client.GetWorkspace(ctx, id)
+	if err != nil {
+		return err
+	}
+	return cmd.print(item, workspaceHeader, [][]string{workspaceRow(*item)})
+}
+
+func createWorkspace(ctx context.Context, cmd *command, fs *flag.FlagSet, args []string) error {
+	var id string
+	fs.StringVar(&id, "id", "", "the id of the new workspace, generated if not set")
+	args, err := cmd.parse(fs, args)
+	if err != nil {
+		return err
+	} else if len(args) != 1 {
+		return fmt.Errorf("expected exactly one display name argument")
+	}
+	body := client.CreateWorkspace{DisplayName: args[0]}
+	if id != "" {
+		body.Id = &id
+	}
+	item, err := cmd.client.CreateWorkspace(ctx, body)
+	if err != nil {
+		return err
+	}
+	return cmd.print(item, workspaceHeader, [][]string{workspaceRow(*item)})
+}
+
+func deleteWorkspace(ctx context.Context, cmd *command, fs *flag.FlagSet, args []string) error {
+	args, err := cmd.parse(fs, args)
+	if err != nil {
+		return err
+	} else if len(args) != 1 {
+		return fmt.Errorf("expected exactly one workspace id argument")
+	}
+	if err := cmd.client.DeleteWorkspace(ctx, args[0]); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(cmd.out, "deleted workspace %s\n", args[0])
+	return nil
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/astromechza/todo-app/pkg/client"
+	"github.com/astromechza/todo-app/pkg/oidc"
+)
+
+const loginCookie = "todo_login"
+
+// loginTTL is how long the user has to sign in at the identity provider.
+const loginTTL = time.Minute * 10
+
+// mockIdpPath is where the mock identity provider is mounted when it is enabled.
+const mockIdpPath = "/mock-idp"
+
+// loginState is kept in a short-lived cookie from sending the user to the identity provider until they return to the
+// callback, it ties the callback to the browser that started the sign in.
+type loginState struct {
+	State    string    `json:"state"`
+	Nonce    string    `json:"nonce"`
+	Verifier string    `json:"verifier"`
+	ReturnTo string    `json:"return_to"`
+	Expires  time.Time `json:"exp"`
+}
+
+// localPath returns the path if it is a path on this site, or / otherwise, so that the return_to of a sign in can not
+// redirect to another site.
+func localPath(path string) string {
+	if !strings.HasPrefix(path, "/") || strings.HasPrefix(path, "//") || strings.HasPrefix(path, "/\\") {
+		return "/"
+	}
+	return path
+}
+
+func (w *webServer) Login(c echo.Context) error {
+	if w.Oidc == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Signing in is not configured.")
+	}
+	state := loginState{
+		State:    oidc.RandomToken(),
+		Nonce:    oidc.RandomToken(),
+		Verifier: oidc.RandomToken(),
+		ReturnTo: localPath(c.QueryParam("return_to")),
+		Expires:  time.Now().Add(loginTTL),
+	}
+	authUrl, err := w.Oidc.AuthCodeUrl(c.Request().Context(), state.State, state.Nonce, state.Verifier)
+	if err != nil {
+		return fmt.Errorf("failed to discover the identity provider: %w", err)
+	}
+	if err := w.Cookies.setCookie(c, loginCookie, state, loginTTL); err != nil {
+		return err
+	}
+	return c.Redirect(http.StatusSeeOther, authUrl)
+}
+
+// LoginCallback finishes the sign in by exchanging the code for an id token, and the id token for a backend token of
+// the user.
+func (w *webServer) LoginCallback(c echo.Context) error {
+	if w.Oidc == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Signing in is not configured.")
+	}
+	var state loginState
+	if !w.Cookies.readCookie(c, loginCookie, &state) || time.Now().After(state.Expires) {
+		return echo.NewHTTPError(http.StatusBadRequest, "The sign in has expired, please try again.")
+	}
+	w.Cookies.clearCookie(c, loginCookie)
+	if code := c.QueryParam("error"); code != "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, fmt.Sprintf("The identity provider did not sign you in: %s %s", code, c.QueryParam("error_description")))
+	}
+	if subtle.ConstantTimeCompare([]byte(c.QueryParam("state")), []byte(state.State)) != 1 {
+		return echo.NewHTTPError(http.StatusBadRequest, "The sign in was not started by this browser, please try again.")
+	}
+
+	idToken, claims, err := w.Oidc.Exchange(c.Request().Context(), c.QueryParam("code"), state.Verifier, state.Nonce)
+	if errors.Is(err, oidc.ErrInvalidToken) {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	} else if err != nil {
+		return fmt.Errorf("failed to exchange the code: %w", err)
+	}
+	token, err := w.Backend.ExchangeIdToken(c.Request().Context(), idToken)
+	if err != nil {
+		return err
+	}
+	user, err := w.Backend.GetCurrentUser(client.ContextWithBearerToken(c.Request().Context(), token.AccessToken))
+	if err != nil {
+		return err
+	}
+	slog.Info("user signed in", "user", user.Id, "issuer", claims.Issuer, "subject", claims.Subject)
+	// the csrf token is replaced so that a token seen before the sign in is not valid for the user
+	if err := w.saveSession(c, &session{
+		CsrfToken:    oidc.RandomToken(),
+		UserId:       user.Id,
+		UserName:     user.DisplayName,
+		BackendToken: token.AccessToken,
+		ExpiresAt:    token.ExpiresAt,
+	}); err != nil {
+		return err
+	}
+	return c.Redirect(http.StatusSeeOther, state.ReturnTo)
+}
+
+// Logout signs the user out of the frontend, they remain signed in at the identity provider.
+func (w *webServer) Logout(c echo.Context) error {
+	if err := w.saveSession(c, newSession()); err != nil {
+		return err
+	}
+	return c.Redirect(http.StatusSeeOther, "/")
+}
@@ -1,8 +1,22 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"log/slog"
+	"net/http"
 	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/astromechza/todo-app/pkg/client"
+	"github.com/astromechza/todo-app/pkg/oidc"
 )
 
 func main() {
@@ -12,6 +26,124 @@ func main() {
 	}
 }
 
+const defaultBackendUrl = "http://localhost:8080"
+const defaultPort = 8081
+
+// defaultMockClientId is the client id used with the mock identity provider when OIDC_CLIENT_ID is not set.
+const defaultMockClientId = "todo-app"
+
+// newBackendClient builds the client of the backend api. Without a token the frontend can only show the public
+// workspace to anyone who has not signed in. The token is sent with every request that is not made by a signed in user,
+// so it cannot be used when users sign in, since every anonymous visitor would act with its credentials.
+func newBackendClient(backendUrl string, token string, loginEnabled bool) (*client.Client, error) {
+	opts := []client.ClientOption{client.WithHTTPClient(&http.Client{Timeout: time.Second * 10})}
+	if token != "" {
+		if loginEnabled {
+			return nil, fmt.Errorf("BACKEND_TOKEN must not be set when users sign in with OpenID Connect")
+		}
+		opts = append(opts, client.WithBearerToken(token))
+	}
+	return client.New(backendUrl, opts...)
+}
+
 func mainInner() error {
-	return nil
+	backendUrl := os.Getenv("BACKEND_URL")
+	if backendUrl == "" {
+		backendUrl = defaultBackendUrl
+	}
+	port := defaultPort
+	if raw := os.Getenv("PORT"); raw != "" {
+		if v, err := strconv.Atoi(raw); err != nil {
+			return fmt.Errorf("invalid PORT '%s': %w", raw, err)
+		} else {
+			port = v
+		}
+	}
+
+	// the public url is where browsers reach the frontend, the identity provider redirects back to it
+	publicUrl := strings.TrimSuffix(os.Getenv("PUBLIC_URL"), "/")
+	if publicUrl == "" {
+		publicUrl = fmt.Sprintf("http://localhost:%d", port)
+	}
+	sessionKey := []byte(os.Getenv("SESSION_KEY"))
+	if len(sessionKey) == 0 {
+		slog.Warn("SESSION_KEY is not set, sessions will not be valid across restarts or replicas")
+		sessionKey = []byte(oidc.RandomToken())
+	}
+	cookies, err := newCookieSealer(sessionKey, strings.HasPrefix(publicUrl, "https://"))
+	if err != nil {
+		return fmt.Errorf("failed to build cookie sealer: %w", err)
+	}
+	web := &webServer{Cookies: cookies}
+
+	issuer, clientId := os.Getenv("OIDC_ISSUER"), os.Getenv("OIDC_CLIENT_ID")
+	if os.Getenv("OIDC_MOCK") == "true" {
+		issuer = publicUrl + mockIdpPath
+		if clientId == "" {
+			clientId = defaultMockClientId
+		}
+		if web.MockIdp, err = oidc.NewMockProvider(issuer); err != nil {
+			return fmt.Errorf("failed to build mock identity provider: %w", err)
+		}
+		slog.Warn("serving the mock identity provider, anyone can sign in as any user", "issuer", issuer)
+	}
+	if issuer != "" {
+		if clientId == "" {
+			return fmt.Errorf("OIDC_CLIENT_ID is required when OIDC_ISSUER is set")
+		}
+		web.Oidc = &oidc.Client{
+			Provider:     oidc.NewProvider(issuer, &http.Client{Timeout: time.Second * 10}),
+			ClientId:     clientId,
+			ClientSecret: os.Getenv("OIDC_CLIENT_SECRET"),
+			RedirectUrl:  publicUrl + "/auth/callback",
+		}
+	}
+	if web.Backend, err = newBackendClient(backendUrl, os.Getenv("BACKEND_TOKEN"), web.Oidc != nil); err != nil {
+		return fmt.Errorf("failed to build backend client: %w", err)
+	}
+
+	renderer, err := newTemplateRenderer()
+	if err != nil {
+		return err
+	}
+
+	echoServer := echo.New()
+	echoServer.HidePort = true
+	echoServer.HideBanner = true
+	echoServer.Renderer = renderer
+	echoServer.HTTPErrorHandler = web.defaultErrorHandler
+	web.Register(echoServer)
+
+	defer func() {
+		if err := echoServer.Close(); err != nil {
+			slog.Warn("closing server failed", "err", err)
+		}
+	}()
+
+	listenError := make(chan error)
+	go func() {
+		addr := fmt.Sprintf(":%d", port)
+		slog.Info("starting server", "addr", addr, "backend", backendUrl, "public", publicUrl)
+		if err := echoServer.Start(addr); err != nil {
+			if !errors.Is(err, http.ErrServerClosed) {
+				listenError <- err
+			}
+		}
+	}()
+
+	exit := make(chan os.Signal, 1) // we need to reserve to buffer size 1, so the notifier are not blocked
+	signal.Notify(exit, syscall.SIGINT, syscall.SIGTERM)
+
+	select {
+	case sig := <-exit:
+		slog.Info("received signal", "signal", sig)
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+		if err := echoServer.Shutdown(shutdownCtx); err != nil {
+			slog.Error("stopping http server failed", "err", err)
+		}
+		return nil
+	case err := <-listenError:
+		return fmt.Errorf("failed to listen: %w", err)
+	}
 }
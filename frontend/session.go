@@ -0,0 +1,185 @@
+package main
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/astromechza/todo-app/pkg/client"
+	"github.com/astromechza/todo-app/pkg/oidc"
+)
+
+const sessionCookie = "todo_session"
+
+// csrfField is the name of the hidden form field, or the X-CSRF-Token header, that carries the csrf token.
+const csrfField = "csrf_token"
+
+// session is the state of a browser, kept in an encrypted cookie so that the frontend itself stays stateless. Every
+// browser has a session for its csrf token, the user is only set while they are signed in.
+type session struct {
+	CsrfToken string `json:"csrf"`
+	UserId    string `json:"uid,omitempty"`
+	UserName  string `json:"name,omitempty"`
+	// BackendToken is the bearer token that the requests of the user are forwarded to the backend with.
+	BackendToken string    `json:"token,omitempty"`
+	ExpiresAt    time.Time `json:"exp,omitempty"`
+}
+
+func newSession() *session {
+	return &session{CsrfToken: oidc.RandomToken()}
+}
+
+func (s *session) SignedIn() bool {
+	return s.UserId != ""
+}
+
+// cookieSealer encrypts and authenticates cookie values with AES-GCM, so that they can neither be read nor forged.
+type cookieSealer struct {
+	aead cipher.AEAD
+	// secure marks the cookies as https only.
+	secure bool
+}
+
+// newCookieSealer derives the encryption key from a key of any length.
+func newCookieSealer(key []byte, secure bool) (*cookieSealer, error) {
+	sum := sha256.Sum256(key)
+	block, err := aes.NewCipher(sum[:])
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &cookieSealer{aead: aead, secure: secure}, nil
+}
+
+// seal encrypts the json of the value, the cookie name is authenticated too so a value cannot be moved to another
+// cookie.
+func (s *cookieSealer) seal(name string, value interface{}) (string, error) {
+	plain, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, s.aead.NonceSize(), s.aead.NonceSize()+len(plain)+s.aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(s.aead.Seal(nonce, nonce, plain, []byte(name))), nil
+}
+
+func (s *cookieSealer) open(name string, raw string, into interface{}) error {
+	sealed, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil || len(sealed) < s.aead.NonceSize() {
+		return errors.New("malformed cookie")
+	}
+	plain, err := s.aead.Open(nil, sealed[:s.aead.NonceSize()], sealed[s.aead.NonceSize():], []byte(name))
+	if err != nil {
+		return errors.New("invalid cookie")
+	}
+	return json.Unmarshal(plain, into)
+}
+
+// setCookie stores the value in an http only cookie, a zero max age keeps it until the browser is closed.
+func (s *cookieSealer) setCookie(c echo.Context, name string, value interface{}, maxAge time.Duration) error {
+	raw, err := s.seal(name, value)
+	if err != nil {
+		return fmt.Errorf("failed to seal cookie: %w", err)
+	}
+	c.SetCookie(&http.Cookie{
+		Name:     name,
+		Value:    raw,
+		Path:     "/",
+		MaxAge:   int(maxAge.Seconds()),
+		Secure:   s.secure,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+	return nil
+}
+
+// readCookie reports whether the cookie is set and could be opened into the value.
+func (s *cookieSealer) readCookie(c echo.Context, name string, into interface{}) bool {
+	cookie, err := c.Cookie(name)
+	return err == nil && s.open(name, cookie.Value, into) == nil
+}
+
+func (s *cookieSealer) clearCookie(c echo.Context, name string) {
+	c.SetCookie(&http.Cookie{Name: name, Path: "/", MaxAge: -1, Secure: s.secure, HttpOnly: true, SameSite: http.SameSiteLaxMode})
+}
+
+// sessionFrom returns the session loaded by the session middleware.
+func sessionFrom(c echo.Context) *session {
+	if s, ok := c.Get("session").(*session); ok {
+		return s
+	}
+	return newSession()
+}
+
+// saveSession replaces the session of the browser.
+func (w *webServer) saveSession(c echo.Context, s *session) error {
+	c.Set("session", s)
+	return w.Cookies.setCookie(c, sessionCookie, s, 0)
+}
+
+// isSafeMethod reports whether the method only reads, so that it does not need a csrf token.
+func isSafeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
+// SessionMiddleware loads the session of the browser, starting a new one when there is none, and rejects any request
+// that changes something unless it carries the csrf token of the session. This protects the forms of the signed in
+// users from being submitted by other sites.
+func (w *webServer) SessionMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		// the mock identity provider is a separate site that is only mounted here for convenience
+		if strings.HasPrefix(c.Request().URL.Path, mockIdpPath+"/") {
+			return next(c)
+		}
+		var s session
+		if !w.Cookies.readCookie(c, sessionCookie, &s) || s.CsrfToken == "" {
+			if err := w.saveSession(c, newSession()); err != nil {
+				return err
+			}
+		} else if s.SignedIn() && time.Now().After(s.ExpiresAt) {
+			// the backend token has expired, so the user has to sign in again
+			if err := w.saveSession(c, &session{CsrfToken: s.CsrfToken}); err != nil {
+				return err
+			}
+		} else {
+			c.Set("session", &s)
+		}
+
+		if !isSafeMethod(c.Request().Method) {
+			token := c.Request().Header.Get("X-CSRF-Token")
+			if token == "" {
+				token = c.FormValue(csrfField)
+			}
+			if subtle.ConstantTimeCompare([]byte(token), []byte(sessionFrom(c).CsrfToken)) != 1 {
+				return echo.NewHTTPError(http.StatusForbidden, "The form has expired or was not submitted from this site, reload the page and try again.")
+			}
+		}
+		return next(c)
+	}
+}
+
+// backendContext returns the context of the request with the credentials of the signed in user, if any, so that the
+// backend sees the request as made by them.
+func backendContext(c echo.Context) context.Context {
+	if s := sessionFrom(c); s.SignedIn() {
+		return client.ContextWithBearerToken(c.Request().Context(), s.BackendToken)
+	}
+	return c.Request().Context()
+}
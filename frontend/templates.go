@@ -0,0 +1,52 @@
+package main
+
+import (
+	"embed"
+	"fmt"
+	"html/template"
+	"io"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+//go:embed templates/*.html
+var embedTemplates embed.FS
+
+type templateRenderer struct {
+	pages map[string]*template.Template
+}
+
+var templateFuncs = template.FuncMap{
+	"timestamp": func(t time.Time) string {
+		return t.Local().Format(time.DateTime)
+	},
+}
+
+// newTemplateRenderer parses each page template together with the shared layout.
+func newTemplateRenderer() (*templateRenderer, error) {
+	pages, err := embedTemplates.ReadDir("templates")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read templates: %w", err)
+	}
+	out := &templateRenderer{pages: make(map[string]*template.Template, len(pages))}
+	for _, page := range pages {
+		if page.Name() == "layout.html" {
+			continue
+		}
+		t, err := template.New(page.Name()).Funcs(templateFuncs).ParseFS(embedTemplates, "templates/layout.html", "templates/"+page.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse template '%s': %w", page.Name(), err)
+		}
+		out.pages[page.Name()] = t
+	}
+	return out, nil
+}
+
+func (t *templateRenderer) Render(w io.Writer, name string, data interface{}, _ echo.Context) error {
+	page, ok := t.pages[name]
+	if !ok {
+		return fmt.Errorf("template '%s' not found", name)
+	}
+	return page.ExecuteTemplate(w, "layout", data)
+}
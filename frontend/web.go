@@ -0,0 +1,252 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/astromechza/todo-app/pkg/client"
+	"github.com/astromechza/todo-app/pkg/oidc"
+)
+
+type webServer struct {
+	Backend *client.Client
+	// Cookies seals the session and login cookies.
+	Cookies *cookieSealer
+	// Oidc signs users in with OpenID Connect, signing in is disabled when it is nil.
+	Oidc *oidc.Client
+	// MockIdp is mounted at /mock-idp when it is set.
+	MockIdp *oidc.MockProvider
+}
+
+func (w *webServer) Register(e *echo.Echo) {
+	e.Use(w.SessionMiddleware)
+	if w.MockIdp != nil {
+		e.Any(mockIdpPath+"/*", echo.WrapHandler(http.StripPrefix(mockIdpPath, w.MockIdp)))
+	}
+	e.GET("/login", w.Login)
+	e.GET("/auth/callback", w.LoginCallback)
+	e.POST("/logout", w.Logout)
+	e.GET("/", func(c echo.Context) error {
+		return c.Redirect(http.StatusSeeOther, "/workspace/public/todos")
+	})
+	e.GET("/workspace/:workspaceId/todos", w.ListTodos)
+	e.POST("/workspace/:workspaceId/todos", w.CreateTodo)
+	e.GET("/workspace/:workspaceId/todos/:todoId", w.GetTodo)
+	e.POST("/workspace/:workspaceId/todos/:todoId/delete", w.DeleteTodo)
+}
+
+func todoUrl(workspaceId, todoId string) string {
+	return fmt.Sprintf("/workspace/%s/todos/%s", url.PathEscape(workspaceId), url.PathEscape(todoId))
+}
+
+func todosUrl(workspaceId string) string {
+	return fmt.Sprintf("/workspace/%s/todos", url.PathEscape(workspaceId))
+}
+
+// layoutPage is embedded in every page for the header of the layout.
+type layoutPage struct {
+	Session      *session
+	LoginEnabled bool
+	// Path is where the user returns to after signing in.
+	Path string
+}
+
+func (w *webServer) layout(c echo.Context) layoutPage {
+	out := layoutPage{Session: sessionFrom(c), LoginEnabled: w.Oidc != nil, Path: "/"}
+	// only a page that was read can be returned to
+	if c.Request().Method == http.MethodGet {
+		out.Path = c.Request().URL.RequestURI()
+	}
+	return out
+}
+
+type sortOption struct {
+	Value string
+	Label string
+}
+
+// sortOptions are the orders offered on the list page, a - prefix sorts in descending order.
+var sortOptions = []sortOption{
+	{"", "Group and id"},
+	{"-updated_at", "Recently updated"},
+	{"updated_at", "Least recently updated"},
+	{"-created_at", "Newest"},
+	{"created_at", "Oldest"},
+	{"title", "Title"},
+}
+
+type listPage struct {
+	layoutPage
+	WorkspaceId  string
+	Items        []client.Todo
+	Remaining    int
+	NextPageUrl  string
+	FirstPageUrl string
+	IsFirstPage  bool
+	Status       []string
+	Assignee     string
+	Query        string
+	Sort         string
+	SortOptions  []sortOption
+}
+
+func (w *webServer) ListTodos(c echo.Context) error {
+	workspaceId := c.Param("workspaceId")
+	params := client.ListTodosParams{}
+	if v := c.QueryParam("page"); v != "" {
+		params.Page = &v
+	}
+	if v := c.QueryParams()["status"]; len(v) > 0 {
+		params.Status = &v
+	}
+	if v := c.QueryParam("assignee"); v != "" {
+		params.Assignee = &v
+	}
+	if v := strings.TrimSpace(c.QueryParam("q")); v != "" {
+		params.Q = &v
+	}
+	// the sort is a field name, optionally prefixed by - for a descending order
+	sort := c.QueryParam("sort")
+	if field := strings.TrimPrefix(sort, "-"); field != "" {
+		sortField := client.ListTodosParamsSort(field)
+		params.Sort = &sortField
+		if field != sort {
+			order := client.ListTodosParamsOrderDesc
+			params.Order = &order
+		}
+	}
+	res, err := w.Backend.ListTodos(backendContext(c), workspaceId, params)
+	if err != nil {
+		return err
+	}
+
+	page := listPage{
+		layoutPage:   w.layout(c),
+		WorkspaceId:  workspaceId,
+		Items:        res.Items,
+		Remaining:    res.RemainingItems,
+		FirstPageUrl: todosUrl(workspaceId),
+		IsFirstPage:  params.Page == nil,
+		Status:       c.QueryParams()["status"],
+		Assignee:     c.QueryParam("assignee"),
+		Sort:         sort,
+		SortOptions:  sortOptions,
+	}
+	if params.Q != nil {
+		page.Query = *params.Q
+	}
+	filters := url.Values{}
+	if len(page.Status) > 0 {
+		filters["status"] = page.Status
+	}
+	if page.Assignee != "" {
+		filters.Set("assignee", page.Assignee)
+	}
+	if page.Query != "" {
+		filters.Set("q", page.Query)
+	}
+	if page.Sort != "" {
+		filters.Set("sort", page.Sort)
+	}
+	if res.NextPageToken != nil {
+		query := url.Values{"page": []string{*res.NextPageToken}}
+		for k, v := range filters {
+			query[k] = v
+		}
+		page.NextPageUrl = todosUrl(workspaceId) + "?" + query.Encode()
+	}
+	if len(filters) > 0 {
+		page.FirstPageUrl += "?" + filters.Encode()
+	}
+	return c.Render(http.StatusOK, "list.html", page)
+}
+
+func (w *webServer) CreateTodo(c echo.Context) error {
+	workspaceId := c.Param("workspaceId")
+	body := client.CreateTodo{Title: c.FormValue("title")}
+	if v := strings.TrimSpace(c.FormValue("group_id")); v != "" {
+		body.GroupId = &v
+	}
+	if v := strings.TrimSpace(c.FormValue("details")); v != "" {
+		body.Details = &v
+	}
+	res, err := w.Backend.CreateTodo(backendContext(c), workspaceId, body)
+	if err != nil {
+		return err
+	}
+	return c.Redirect(http.StatusSeeOther, todoUrl(workspaceId, res.Metadata.Id))
+}
+
+type viewPage struct {
+	layoutPage
+	WorkspaceId string
+	Todo        client.Todo
+	ETag        string
+	ListUrl     string
+	DeleteUrl   string
+}
+
+func (w *webServer) GetTodo(c echo.Context) error {
+	workspaceId, todoId := c.Param("workspaceId"), c.Param("todoId")
+	res, etag, err := w.Backend.GetTodoWithETag(backendContext(c), workspaceId, todoId)
+	if err != nil {
+		return err
+	}
+	return c.Render(http.StatusOK, "view.html", viewPage{
+		layoutPage:  w.layout(c),
+		WorkspaceId: workspaceId,
+		Todo:        *res,
+		ETag:        etag,
+		ListUrl:     todosUrl(workspaceId),
+		DeleteUrl:   todoUrl(workspaceId, todoId) + "/delete",
+	})
+}
+
+func (w *webServer) DeleteTodo(c echo.Context) error {
+	workspaceId, todoId := c.Param("workspaceId"), c.Param("todoId")
+	// the etag of the viewed todo makes sure we don't delete a todo that was changed since it was displayed
+	params := client.DeleteTodoParams{}
+	if etag := c.FormValue("etag"); etag != "" {
+		params.IfMatch = &etag
+	}
+	if err := w.Backend.DeleteTodo(backendContext(c), workspaceId, todoId, params); err != nil {
+		return err
+	}
+	return c.Redirect(http.StatusSeeOther, todosUrl(workspaceId))
+}
+
+type errorPage struct {
+	layoutPage
+	Status int
+	Title  string
+	Detail string
+}
+
+func (w *webServer) defaultErrorHandler(err error, c echo.Context) {
+	page := errorPage{
+		Status: http.StatusInternalServerError,
+		Title:  "An internal error occurred",
+		Detail: "An error occurred while processing the request. Please retry the request if necessary.",
+	}
+	var he *echo.HTTPError
+	if problem, ok := client.AsProblem(err); ok {
+		page = errorPage{Status: problem.Status, Title: problem.Title, Detail: problem.Detail}
+	} else if errors.As(err, &he) {
+		page = errorPage{Status: he.Code, Title: http.StatusText(he.Code), Detail: fmt.Sprint(he.Message)}
+	} else {
+		slog.Error("handling error in default error handler", "err", err, "method", c.Request().Method, "url", c.Request().URL.String())
+	}
+	if c.Response().Committed {
+		return
+	}
+	page.layoutPage = w.layout(c)
+	if err := c.Render(page.Status, "error.html", page); err != nil {
+		slog.Warn("failed to write default error response", "err", err)
+	}
+}
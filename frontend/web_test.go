@@ -0,0 +1,168 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/astromechza/todo-app/backend/api"
+	"github.com/astromechza/todo-app/backend/auth"
+	"github.com/astromechza/todo-app/backend/model"
+	"github.com/astromechza/todo-app/backend/model/memmodel"
+	"github.com/astromechza/todo-app/pkg/oidc"
+)
+
+// newTestBackend serves the backend api over an in-memory model, accepting the id tokens of the issuer.
+func newTestBackend(t *testing.T, issuer string) *httptest.Server {
+	spec, err := os.ReadFile("../backend/api.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	validator, err := api.BuildOpenApiValidator(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := echo.New()
+	e.HTTPErrorHandler = api.DefaultErrorHandler
+	e.JSONSerializer = new(api.DefaultJsonSerializer)
+	db := memmodel.NewMemModel(model.NewPageTokenSigner(model.RandomPageTokenKey(), model.DefaultPageTokenTTL))
+	tokens := auth.NewSigner(auth.RandomKey(), auth.DefaultTokenTTL)
+	e.Use(api.BuildAuthMiddleware(db, tokens))
+	e.Use(validator)
+	server := &api.Server{Database: db, Events: model.NewEventBroker(model.DefaultEventBufferSize), Tokens: tokens, Oidc: oidc.NewProvider(issuer, nil), OidcClientId: defaultMockClientId}
+	api.RegisterHandlers(e, api.NewStrictHandler(server, []api.StrictMiddlewareFunc{api.BuildRoleMiddleware()}))
+	out := httptest.NewServer(e)
+	t.Cleanup(out.Close)
+	return out
+}
+
+var csrfPattern = regexp.MustCompile(`name="csrf_token" value="([^"]+)"`)
+
+// readPage returns the status and body of the page that a request ended up at after following any redirects.
+func readPage(t *testing.T, res *http.Response, err error) (int, string) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res.StatusCode, string(body)
+}
+
+func TestLoginWithMockIdentityProvider(t *testing.T) {
+	var handler http.Handler
+	frontend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler.ServeHTTP(w, r)
+	}))
+	defer frontend.Close()
+	issuer := frontend.URL + mockIdpPath
+
+	// record the credentials that the frontend sends to the backend
+	backendApi := newTestBackend(t, issuer).Config.Handler
+	var lock sync.Mutex
+	var authorizations []string
+	recorder := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		lock.Unlock()
+		backendApi.ServeHTTP(w, r)
+	}))
+	defer recorder.Close()
+	// takeAuthorizations returns the Authorization headers received by the backend since it was last called
+	takeAuthorizations := func() []string {
+		lock.Lock()
+		defer lock.Unlock()
+		out := authorizations
+		authorizations = nil
+		return out
+	}
+	if _, err := newBackendClient(recorder.URL, "service-token", true); err == nil {
+		t.Fatal("expected a backend token to be rejected when users sign in")
+	}
+	backend, err := newBackendClient(recorder.URL, "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cookies, err := newCookieSealer([]byte("test"), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mock, err := oidc.NewMockProvider(issuer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	web := &webServer{
+		Backend: backend,
+		Cookies: cookies,
+		Oidc:    &oidc.Client{Provider: oidc.NewProvider(issuer, nil), ClientId: defaultMockClientId, RedirectUrl: frontend.URL + "/auth/callback"},
+		MockIdp: mock,
+	}
+	renderer, err := newTemplateRenderer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := echo.New()
+	e.Renderer = renderer
+	e.HTTPErrorHandler = web.defaultErrorHandler
+	web.Register(e)
+	handler = e
+
+	jar, _ := cookiejar.New(nil)
+	browser := &http.Client{Jar: jar}
+
+	// the sign in shows the login form of the mock provider, which redirects back to the page the user came from
+	res, err := browser.Get(frontend.URL + "/login?return_to=" + url.QueryEscape("/workspace/public/todos"))
+	if code, body := readPage(t, res, err); code != http.StatusOK || !strings.Contains(body, "Mock identity provider") {
+		t.Fatalf("unexpected login form %d %s", code, body)
+	}
+	res, err = browser.PostForm(res.Request.URL.String(), url.Values{"username": {"alice"}})
+	code, body := readPage(t, res, err)
+	if code != http.StatusOK || res.Request.URL.Path != "/workspace/public/todos" || !strings.Contains(body, "Signed in as alice") {
+		t.Fatalf("unexpected page after sign in %d %s %s", code, res.Request.URL, body)
+	}
+	match := csrfPattern.FindStringSubmatch(body)
+	if match == nil {
+		t.Fatalf("no csrf token in %s", body)
+	}
+
+	// forms are rejected without the csrf token of the session
+	res, err = browser.PostForm(frontend.URL+"/workspace/public/todos", url.Values{"title": {"forged"}})
+	if code, _ := readPage(t, res, err); code != http.StatusForbidden {
+		t.Errorf("unexpected status %d without a csrf token", code)
+	}
+	res, err = browser.PostForm(frontend.URL+"/workspace/public/todos", url.Values{"title": {"signed in"}, "csrf_token": {match[1]}})
+	if code, body := readPage(t, res, err); code != http.StatusOK || !strings.Contains(body, "signed in") {
+		t.Errorf("unexpected status %d with a csrf token %s", code, body)
+	}
+
+	if got := takeAuthorizations(); len(got) == 0 || !strings.HasPrefix(got[len(got)-1], "Bearer ") {
+		t.Errorf("expected the request of the signed in user to carry their token, got %q", got)
+	}
+
+	// once signed out the requests reach the backend without any credentials
+	res, err = browser.PostForm(frontend.URL+"/logout", url.Values{"csrf_token": {match[1]}})
+	if code, body := readPage(t, res, err); code != http.StatusOK || strings.Contains(body, "Signed in as") || !strings.Contains(body, "Sign in") {
+		t.Errorf("unexpected page after sign out %d %s", code, body)
+	}
+	got := takeAuthorizations()
+	if len(got) == 0 {
+		t.Fatal("expected the page after sign out to be read from the backend")
+	}
+	for _, authorization := range got {
+		if authorization != "" {
+			t.Errorf("expected an anonymous request to the backend, got %q", authorization)
+		}
+	}
+}
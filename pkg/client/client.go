@@ -0,0 +1,587 @@
+// Package client is a Go client for the Todo-App API. The raw generated client is available as RawClient and
+// ClientWithResponses, while Client wraps it with typed errors and pagination helpers.
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+//go:generate go run github.com/deepmap/oapi-codegen/v2/cmd/oapi-codegen --config=oapi-codegen.cfg.yaml ../../backend/api.yaml
+
+// ErrNotFound is returned when the api responds with a 404 Problem.
+type ErrNotFound struct{ Problem }
+
+// ErrBadRequest is returned when the api responds with a 400 Problem.
+type ErrBadRequest struct{ Problem }
+
+// ErrConflict is returned when the api responds with a 409 Problem.
+type ErrConflict struct{ Problem }
+
+// ErrPreconditionFailed is returned when the api responds with a 412 Problem because an If-Match header no longer
+// matches the resource.
+type ErrPreconditionFailed struct{ Problem }
+
+// ErrUnauthorized is returned when the api responds with a 401 Problem because the request needs valid credentials.
+type ErrUnauthorized struct{ Problem }
+
+// ErrForbidden is returned when the api responds with a 403 Problem because the user may not access the resource.
+type ErrForbidden struct{ Problem }
+
+// ErrProblem is returned for any other unsuccessful api response.
+type ErrProblem struct{ Problem }
+
+func (p Problem) Error() string {
+	if p.Detail == "" {
+		return fmt.Sprintf("%d %s", p.Status, p.Title)
+	}
+	return fmt.Sprintf("%d %s: %s", p.Status, p.Title, p.Detail)
+}
+
+// AsProblem returns the Problem carried by any of the typed api errors.
+func AsProblem(err error) (*Problem, bool) {
+	var nf ErrNotFound
+	var br ErrBadRequest
+	var c ErrConflict
+	var pf ErrPreconditionFailed
+	var u ErrUnauthorized
+	var f ErrForbidden
+	var p ErrProblem
+	switch {
+	case errors.As(err, &nf):
+		return &nf.Problem, true
+	case errors.As(err, &br):
+		return &br.Problem, true
+	case errors.As(err, &c):
+		return &c.Problem, true
+	case errors.As(err, &pf):
+		return &pf.Problem, true
+	case errors.As(err, &u):
+		return &u.Problem, true
+	case errors.As(err, &f):
+		return &f.Problem, true
+	case errors.As(err, &p):
+		return &p.Problem, true
+	}
+	return nil, false
+}
+
+// checkResponse converts any non-2xx api response into one of the typed api errors.
+func checkResponse(res *http.Response, body []byte) error {
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return nil
+	}
+	var problem Problem
+	if err := json.Unmarshal(body, &problem); err != nil || problem.Title == "" {
+		problem = Problem{
+			Type:   "about:blank",
+			Status: res.StatusCode,
+			Title:  http.StatusText(res.StatusCode),
+			Detail: strings.TrimSpace(string(body)),
+		}
+	}
+	switch res.StatusCode {
+	case http.StatusNotFound:
+		return ErrNotFound{problem}
+	case http.StatusBadRequest:
+		return ErrBadRequest{problem}
+	case http.StatusConflict:
+		return ErrConflict{problem}
+	case http.StatusPreconditionFailed:
+		return ErrPreconditionFailed{problem}
+	case http.StatusUnauthorized:
+		return ErrUnauthorized{problem}
+	case http.StatusForbidden:
+		return ErrForbidden{problem}
+	default:
+		return ErrProblem{problem}
+	}
+}
+
+// Client is an ergonomic wrapper around the generated api client.
+type Client struct {
+	Raw ClientWithResponsesInterface
+}
+
+// New builds a Client for the api at the given server url.
+func New(server string, opts ...ClientOption) (*Client, error) {
+	// the token of the context is applied last so that it replaces any token of WithBearerToken
+	opts = append(opts[:len(opts):len(opts)], WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		if token, ok := ctx.Value(bearerTokenContextKey{}).(string); ok {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		return nil
+	}))
+	raw, err := NewClientWithResponses(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{Raw: raw}, nil
+}
+
+// WithBearerToken sends the api key or bearer token as the credentials of every request.
+func WithBearerToken(token string) ClientOption {
+	return WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	})
+}
+
+type bearerTokenContextKey struct{}
+
+// ContextWithBearerToken sends the api key or bearer token as the credentials of the requests made with the context, in
+// place of any WithBearerToken option. This lets a server that shares one Client forward the credentials of its users.
+func ContextWithBearerToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, bearerTokenContextKey{}, token)
+}
+
+func (c *Client) GetHealthZ(ctx context.Context) error {
+	res, err := c.Raw.GetHealthZWithResponse(ctx)
+	if err != nil {
+		return err
+	}
+	return checkResponse(res.HTTPResponse, res.Body)
+}
+
+func (c *Client) ListWorkspaces(ctx context.Context, params ListWorkspacesParams) (*WorkspacePage, error) {
+	res, err := c.Raw.ListWorkspacesWithResponse(ctx, &params)
+	if err != nil {
+		return nil, err
+	} else if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+func (c *Client) GetWorkspace(ctx context.Context, workspaceId string) (*Workspace, error) {
+	res, err := c.Raw.GetWorkspaceWithResponse(ctx, workspaceId)
+	if err != nil {
+		return nil, err
+	} else if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+func (c *Client) CreateWorkspace(ctx context.Context, body CreateWorkspace) (*Workspace, error) {
+	res, err := c.Raw.CreateWorkspaceWithResponse(ctx, body)
+	if err != nil {
+		return nil, err
+	} else if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res.JSON201, nil
+}
+
+func (c *Client) DeleteWorkspace(ctx context.Context, workspaceId string) error {
+	res, err := c.Raw.DeleteWorkspaceWithResponse(ctx, workspaceId)
+	if err != nil {
+		return err
+	}
+	return checkResponse(res.HTTPResponse, res.Body)
+}
+
+// CreateUser registers a user. The secret of the returned api key is not available again.
+func (c *Client) CreateUser(ctx context.Context, body CreateUser) (*CreatedUser, error) {
+	res, err := c.Raw.CreateUserWithResponse(ctx, body)
+	if err != nil {
+		return nil, err
+	} else if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res.JSON201, nil
+}
+
+func (c *Client) GetCurrentUser(ctx context.Context) (*User, error) {
+	res, err := c.Raw.GetCurrentUserWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	} else if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+func (c *Client) ListApiKeys(ctx context.Context) ([]ApiKey, error) {
+	res, err := c.Raw.ListApiKeysWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	} else if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res.JSON200.Items, nil
+}
+
+func (c *Client) CreateApiKey(ctx context.Context, body CreateApiKey) (*ApiKey, error) {
+	res, err := c.Raw.CreateApiKeyWithResponse(ctx, body)
+	if err != nil {
+		return nil, err
+	} else if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res.JSON201, nil
+}
+
+func (c *Client) DeleteApiKey(ctx context.Context, keyId string) error {
+	res, err := c.Raw.DeleteApiKeyWithResponse(ctx, keyId)
+	if err != nil {
+		return err
+	}
+	return checkResponse(res.HTTPResponse, res.Body)
+}
+
+// CreateToken exchanges the credentials of the client for a short-lived bearer token.
+func (c *Client) CreateToken(ctx context.Context) (*AccessToken, error) {
+	res, err := c.Raw.CreateTokenWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	} else if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+// ExchangeIdToken exchanges an OpenID Connect id token for a bearer token of the user linked to its identity.
+func (c *Client) ExchangeIdToken(ctx context.Context, idToken string) (*AccessToken, error) {
+	res, err := c.Raw.ExchangeIdTokenWithResponse(ctx, ExchangeIdToken{IdToken: idToken})
+	if err != nil {
+		return nil, err
+	} else if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+func (c *Client) ListWorkspaceMembers(ctx context.Context, workspaceId string) ([]WorkspaceMember, error) {
+	res, err := c.Raw.ListWorkspaceMembersWithResponse(ctx, workspaceId)
+	if err != nil {
+		return nil, err
+	} else if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res.JSON200.Items, nil
+}
+
+func (c *Client) SetWorkspaceMember(ctx context.Context, workspaceId string, userId string, body SetWorkspaceMember) (*WorkspaceMember, error) {
+	res, err := c.Raw.SetWorkspaceMemberWithResponse(ctx, workspaceId, userId, body)
+	if err != nil {
+		return nil, err
+	} else if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+func (c *Client) RemoveWorkspaceMember(ctx context.Context, workspaceId string, userId string) error {
+	res, err := c.Raw.RemoveWorkspaceMemberWithResponse(ctx, workspaceId, userId)
+	if err != nil {
+		return err
+	}
+	return checkResponse(res.HTTPResponse, res.Body)
+}
+
+func (c *Client) ListServiceAccounts(ctx context.Context, workspaceId string) ([]ServiceAccount, error) {
+	res, err := c.Raw.ListServiceAccountsWithResponse(ctx, workspaceId)
+	if err != nil {
+		return nil, err
+	} else if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res.JSON200.Items, nil
+}
+
+func (c *Client) CreateServiceAccount(ctx context.Context, workspaceId string, body CreateServiceAccount) (*ServiceAccount, error) {
+	res, err := c.Raw.CreateServiceAccountWithResponse(ctx, workspaceId, body)
+	if err != nil {
+		return nil, err
+	} else if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res.JSON201, nil
+}
+
+func (c *Client) DeleteServiceAccount(ctx context.Context, workspaceId string, accountId string) error {
+	res, err := c.Raw.DeleteServiceAccountWithResponse(ctx, workspaceId, accountId)
+	if err != nil {
+		return err
+	}
+	return checkResponse(res.HTTPResponse, res.Body)
+}
+
+func (c *Client) ListServiceAccountTokens(ctx context.Context, workspaceId string, accountId string) ([]ServiceAccountToken, error) {
+	res, err := c.Raw.ListServiceAccountTokensWithResponse(ctx, workspaceId, accountId)
+	if err != nil {
+		return nil, err
+	} else if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res.JSON200.Items, nil
+}
+
+// CreateServiceAccountToken issues a token to the service account. The secret of the token is only returned here.
+func (c *Client) CreateServiceAccountToken(ctx context.Context, workspaceId string, accountId string, body CreateServiceAccountToken) (*ServiceAccountToken, error) {
+	res, err := c.Raw.CreateServiceAccountTokenWithResponse(ctx, workspaceId, accountId, body)
+	if err != nil {
+		return nil, err
+	} else if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res.JSON201, nil
+}
+
+func (c *Client) RevokeServiceAccountToken(ctx context.Context, workspaceId string, accountId string, tokenId string) error {
+	res, err := c.Raw.RevokeServiceAccountTokenWithResponse(ctx, workspaceId, accountId, tokenId)
+	if err != nil {
+		return err
+	}
+	return checkResponse(res.HTTPResponse, res.Body)
+}
+
+func (c *Client) GetWorkflow(ctx context.Context, workspaceId string) (*Workflow, error) {
+	res, err := c.Raw.GetWorkflowWithResponse(ctx, workspaceId)
+	if err != nil {
+		return nil, err
+	} else if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+func (c *Client) SetWorkflow(ctx context.Context, workspaceId string, body Workflow) (*Workflow, error) {
+	res, err := c.Raw.SetWorkflowWithResponse(ctx, workspaceId, body)
+	if err != nil {
+		return nil, err
+	} else if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+func (c *Client) ListGroups(ctx context.Context, workspaceId string) ([]Group, error) {
+	res, err := c.Raw.ListGroupsWithResponse(ctx, workspaceId)
+	if err != nil {
+		return nil, err
+	} else if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res.JSON200.Items, nil
+}
+
+func (c *Client) GetGroup(ctx context.Context, workspaceId string, groupId string) (*Group, error) {
+	res, err := c.Raw.GetGroupWithResponse(ctx, workspaceId, groupId)
+	if err != nil {
+		return nil, err
+	} else if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+func (c *Client) CreateGroup(ctx context.Context, workspaceId string, body CreateGroup) (*Group, error) {
+	res, err := c.Raw.CreateGroupWithResponse(ctx, workspaceId, body)
+	if err != nil {
+		return nil, err
+	} else if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res.JSON201, nil
+}
+
+func (c *Client) UpdateGroup(ctx context.Context, workspaceId string, groupId string, body UpdateGroup) (*Group, error) {
+	res, err := c.Raw.UpdateGroupWithResponse(ctx, workspaceId, groupId, body)
+	if err != nil {
+		return nil, err
+	} else if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+func (c *Client) DeleteGroup(ctx context.Context, workspaceId string, groupId string) error {
+	res, err := c.Raw.DeleteGroupWithResponse(ctx, workspaceId, groupId)
+	if err != nil {
+		return err
+	}
+	return checkResponse(res.HTTPResponse, res.Body)
+}
+
+func (c *Client) ListTodos(ctx context.Context, workspaceId string, params ListTodosParams) (*TodoPage, error) {
+	res, err := c.Raw.ListTodosWithResponse(ctx, workspaceId, &params)
+	if err != nil {
+		return nil, err
+	} else if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+func (c *Client) GetTodoHistory(ctx context.Context, workspaceId string, todoId string) ([]TodoRevision, error) {
+	res, err := c.Raw.GetTodoHistoryWithResponse(ctx, workspaceId, todoId)
+	if err != nil {
+		return nil, err
+	} else if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res.JSON200.Items, nil
+}
+
+func (c *Client) ListTrash(ctx context.Context, workspaceId string, params ListTrashParams) (*TodoPage, error) {
+	res, err := c.Raw.ListTrashWithResponse(ctx, workspaceId, &params)
+	if err != nil {
+		return nil, err
+	} else if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+func (c *Client) RestoreTodo(ctx context.Context, workspaceId string, todoId string) (*Todo, error) {
+	res, err := c.Raw.RestoreTodoWithResponse(ctx, workspaceId, todoId)
+	if err != nil {
+		return nil, err
+	} else if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+func (c *Client) GetTodo(ctx context.Context, workspaceId string, todoId string) (*Todo, error) {
+	item, _, err := c.GetTodoWithETag(ctx, workspaceId, todoId)
+	return item, err
+}
+
+// GetTodoWithETag returns the todo along with its ETag, which can be passed as the If-Match param of an update or
+// delete to make sure the todo has not changed in the meantime.
+func (c *Client) GetTodoWithETag(ctx context.Context, workspaceId string, todoId string) (*Todo, string, error) {
+	res, err := c.Raw.GetTodoWithResponse(ctx, workspaceId, todoId)
+	if err != nil {
+		return nil, "", err
+	} else if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, "", err
+	}
+	return res.JSON200, res.HTTPResponse.Header.Get("ETag"), nil
+}
+
+func (c *Client) CreateTodo(ctx context.Context, workspaceId string, body CreateTodo) (*Todo, error) {
+	res, err := c.Raw.CreateTodoWithResponse(ctx, workspaceId, body)
+	if err != nil {
+		return nil, err
+	} else if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res.JSON201, nil
+}
+
+func (c *Client) UpdateTodo(ctx context.Context, workspaceId string, todoId string, params UpdateTodoParams, body UpdateTodo) (*Todo, error) {
+	res, err := c.Raw.UpdateTodoWithResponse(ctx, workspaceId, todoId, &params, body)
+	if err != nil {
+		return nil, err
+	} else if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+// ReplaceTodo replaces every mutable field of the TODO, clearing the optional fields that are not set in the body.
+func (c *Client) ReplaceTodo(ctx context.Context, workspaceId string, todoId string, params ReplaceTodoParams, body ReplaceTodo) (*Todo, error) {
+	res, err := c.Raw.ReplaceTodoWithResponse(ctx, workspaceId, todoId, &params, body)
+	if err != nil {
+		return nil, err
+	} else if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+// BatchTodos applies many operations at once. Unless the batch is partial, a failed operation fails the whole batch
+// and its problem is returned as the error.
+func (c *Client) BatchTodos(ctx context.Context, workspaceId string, body TodoBatch) ([]TodoOperationResult, error) {
+	res, err := c.Raw.BatchTodosWithResponse(ctx, workspaceId, body)
+	if err != nil {
+		return nil, err
+	} else if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res.JSON200.Results, nil
+}
+
+// ExportTodos returns every active TODO in the workspace in the given format.
+func (c *Client) ExportTodos(ctx context.Context, workspaceId string, params ExportTodosParams) ([]byte, error) {
+	res, err := c.Raw.ExportTodosWithResponse(ctx, workspaceId, &params)
+	if err != nil {
+		return nil, err
+	} else if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res.Body, nil
+}
+
+// ImportTodos creates the TODOs read from the body in the given format. Either every TODO is imported or none are.
+func (c *Client) ImportTodos(ctx context.Context, workspaceId string, params ImportTodosParams, body io.Reader) (*TodoImport, error) {
+	res, err := c.Raw.ImportTodosWithBodyWithResponse(ctx, workspaceId, &params, "application/octet-stream", body)
+	if err != nil {
+		return nil, err
+	} else if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+func (c *Client) DeleteTodo(ctx context.Context, workspaceId string, todoId string, params DeleteTodoParams) error {
+	res, err := c.Raw.DeleteTodoWithResponse(ctx, workspaceId, todoId, &params)
+	if err != nil {
+		return err
+	}
+	return checkResponse(res.HTTPResponse, res.Body)
+}
+
+// TodoIterator walks through every TODO matched by a ListTodos call, requesting the following pages as needed.
+type TodoIterator struct {
+	client      *Client
+	workspaceId string
+	params      ListTodosParams
+	page        []Todo
+	current     Todo
+	started     bool
+	err         error
+}
+
+// IterateTodos returns an iterator over all the TODOs matching the params. The Page param is used as the starting
+// point if it is set.
+func (c *Client) IterateTodos(workspaceId string, params ListTodosParams) *TodoIterator {
+	return &TodoIterator{client: c, workspaceId: workspaceId, params: params}
+}
+
+// Next advances the iterator and returns false when there are no more TODOs or an error occurred.
+func (it *TodoIterator) Next(ctx context.Context) bool {
+	for len(it.page) == 0 {
+		if it.err != nil || (it.started && it.params.Page == nil) {
+			return false
+		}
+		it.started = true
+		res, err := it.client.ListTodos(ctx, it.workspaceId, it.params)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page = res.Items
+		it.params.Page = res.NextPageToken
+	}
+	it.current, it.page = it.page[0], it.page[1:]
+	return true
+}
+
+// Todo returns the TODO the iterator is currently positioned on.
+func (it *TodoIterator) Todo() Todo {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *TodoIterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/astromechza/todo-app/pkg/ref"
+)
+
+func TestIterateTodosFollowsPageTokens(t *testing.T) {
+	pages := map[string]TodoPage{
+		"":   {Items: []Todo{{Title: "a"}, {Title: "b"}}, NextPageToken: ref.Ref("p2"), RemainingItems: 1},
+		"p2": {Items: []Todo{{Title: "c"}}},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(pages[r.URL.Query().Get("page")])
+	}))
+	defer server.Close()
+
+	c, err := New(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	it := c.IterateTodos("public", ListTodosParams{})
+	var titles []string
+	for it.Next(context.Background()) {
+		titles = append(titles, it.Todo().Title)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(titles) != 3 || titles[0] != "a" || titles[2] != "c" {
+		t.Fatalf("unexpected titles %v", titles)
+	}
+}
+
+func TestProblemResponsesAreTyped(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(Problem{Type: "about:blank", Status: http.StatusNotFound, Title: "Not found", Detail: "todo not found"})
+	}))
+	defer server.Close()
+
+	c, err := New(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = c.GetTodo(context.Background(), "public", "TODO-1")
+	var nf ErrNotFound
+	if !errors.As(err, &nf) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+	if nf.Detail != "todo not found" {
+		t.Fatalf("unexpected detail %q", nf.Detail)
+	}
+	if p, ok := AsProblem(err); !ok || p.Status != http.StatusNotFound {
+		t.Fatalf("expected problem from error, got %v", p)
+	}
+}
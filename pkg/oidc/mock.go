@@ -0,0 +1,269 @@
+package oidc
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// mockCodeTTL is how long an authorization code of the mock provider can be exchanged for.
+const mockCodeTTL = time.Minute
+
+// mockIdTokenTTL is how long the id tokens of the mock provider are valid for.
+const mockIdTokenTTL = time.Minute * 5
+
+// MockProvider is a tiny OpenID Connect provider for development and tests that works without any network access. It
+// signs in anyone under any username without a password, and accepts any client id and secret, so it must never be
+// exposed in production. The username is used as both the subject and the name of the id token.
+//
+// It serves the discovery document, jwks, authorization and token endpoints relative to the root of the handler, so it
+// should be mounted with http.StripPrefix when the issuer url has a path.
+type MockProvider struct {
+	issuer string
+	key    *rsa.PrivateKey
+	keyId  string
+
+	lock  sync.Mutex
+	codes map[string]mockGrant
+}
+
+// mockGrant is an issued authorization code and the parameters of the authorization request it was issued for.
+type mockGrant struct {
+	clientId      string
+	redirectUri   string
+	codeChallenge string
+	nonce         string
+	username      string
+	expiresAt     time.Time
+}
+
+// NewMockProvider returns a mock provider for the issuer url with a newly generated signing key.
+func NewMockProvider(issuer string) (*MockProvider, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate signing key: %w", err)
+	}
+	return &MockProvider{
+		issuer: strings.TrimSuffix(issuer, "/"),
+		key:    key,
+		keyId:  RandomToken()[:16],
+		codes:  make(map[string]mockGrant),
+	}, nil
+}
+
+func (m *MockProvider) Issuer() string {
+	return m.issuer
+}
+
+func (m *MockProvider) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/.well-known/openid-configuration":
+		writeJson(w, http.StatusOK, map[string]interface{}{
+			"issuer":                                m.issuer,
+			"authorization_endpoint":                m.issuer + "/authorize",
+			"token_endpoint":                        m.issuer + "/token",
+			"jwks_uri":                              m.issuer + "/jwks",
+			"response_types_supported":              []string{"code"},
+			"subject_types_supported":               []string{"public"},
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+			"code_challenge_methods_supported":      []string{"S256"},
+			"scopes_supported":                      []string{"openid", "profile", "email"},
+		})
+	case "/jwks":
+		writeJson(w, http.StatusOK, jsonWebKeySet{Keys: []jsonWebKey{{
+			Kty: "RSA",
+			Kid: m.keyId,
+			Use: "sig",
+			Alg: "RS256",
+			N:   base64.RawURLEncoding.EncodeToString(m.key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(m.key.E)).Bytes()),
+		}}})
+	case "/authorize":
+		m.authorize(w, r)
+	case "/token":
+		m.token(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func writeJson(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+var mockLoginPage = template.Must(template.New("login").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head><meta charset="utf-8"><title>Mock sign in</title></head>
+<body style="font-family: sans-serif; max-width: 30rem; margin: 2rem auto;">
+<h1>Mock identity provider</h1>
+<p>Sign in to <strong>{{ .client_id }}</strong> as any user, there is no password.</p>
+<form method="post">
+    {{ range $k, $v := . }}<input type="hidden" name="{{ $k }}" value="{{ $v }}">{{ end }}
+    <label>Username <input name="username" required maxlength="100" autofocus></label>
+    <button type="submit">Sign in</button>
+</form>
+</body>
+</html>
+`))
+
+// authorize shows a login form for any username, or signs in the user of the login_hint parameter directly so that
+// scripts and tests do not need to submit the form.
+func (m *MockProvider) authorize(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	redirectUri, err := url.Parse(r.Form.Get("redirect_uri"))
+	if err != nil || !redirectUri.IsAbs() {
+		http.Error(w, "redirect_uri must be an absolute url", http.StatusBadRequest)
+		return
+	}
+	switch {
+	case r.Form.Get("response_type") != "code":
+		http.Error(w, "only the 'code' response_type is supported", http.StatusBadRequest)
+		return
+	case r.Form.Get("client_id") == "":
+		http.Error(w, "client_id is required", http.StatusBadRequest)
+		return
+	case r.Form.Get("code_challenge") == "" || r.Form.Get("code_challenge_method") != "S256":
+		http.Error(w, "an S256 code_challenge is required", http.StatusBadRequest)
+		return
+	}
+	username := strings.TrimSpace(r.Form.Get("username"))
+	if r.Method == http.MethodGet {
+		username = strings.TrimSpace(r.Form.Get("login_hint"))
+	}
+	if username == "" || len(username) > 100 {
+		params := make(map[string]string)
+		for _, k := range []string{"response_type", "client_id", "redirect_uri", "scope", "state", "nonce", "code_challenge", "code_challenge_method"} {
+			params[k] = r.Form.Get(k)
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_ = mockLoginPage.Execute(w, params)
+		return
+	}
+
+	code := RandomToken()
+	m.lock.Lock()
+	now := time.Now()
+	for k, grant := range m.codes {
+		if now.After(grant.expiresAt) {
+			delete(m.codes, k)
+		}
+	}
+	m.codes[code] = mockGrant{
+		clientId:      r.Form.Get("client_id"),
+		redirectUri:   redirectUri.String(),
+		codeChallenge: r.Form.Get("code_challenge"),
+		nonce:         r.Form.Get("nonce"),
+		username:      username,
+		expiresAt:     now.Add(mockCodeTTL),
+	}
+	m.lock.Unlock()
+
+	query := redirectUri.Query()
+	query.Set("code", code)
+	if state := r.Form.Get("state"); state != "" {
+		query.Set("state", state)
+	}
+	redirectUri.RawQuery = query.Encode()
+	http.Redirect(w, r, redirectUri.String(), http.StatusSeeOther)
+}
+
+func tokenError(w http.ResponseWriter, code string, description string) {
+	writeJson(w, http.StatusBadRequest, map[string]string{"error": code, "error_description": description})
+}
+
+// token exchanges an authorization code for an id token, checking that the PKCE code verifier matches the challenge.
+func (m *MockProvider) token(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		tokenError(w, "invalid_request", err.Error())
+		return
+	}
+	if r.PostForm.Get("grant_type") != "authorization_code" {
+		tokenError(w, "unsupported_grant_type", "only the authorization_code grant is supported")
+		return
+	}
+	clientId := r.PostForm.Get("client_id")
+	if user, _, ok := r.BasicAuth(); ok {
+		clientId, _ = url.QueryUnescape(user)
+	}
+
+	m.lock.Lock()
+	grant, ok := m.codes[r.PostForm.Get("code")]
+	delete(m.codes, r.PostForm.Get("code"))
+	m.lock.Unlock()
+	challenge := CodeChallenge(r.PostForm.Get("code_verifier"))
+	switch {
+	case !ok || time.Now().After(grant.expiresAt):
+		tokenError(w, "invalid_grant", "the code is invalid or has expired")
+		return
+	case grant.clientId != clientId || grant.redirectUri != r.PostForm.Get("redirect_uri"):
+		tokenError(w, "invalid_grant", "the code was issued to another client or redirect uri")
+		return
+	case subtle.ConstantTimeCompare([]byte(challenge), []byte(grant.codeChallenge)) != 1:
+		tokenError(w, "invalid_grant", "the code verifier does not match the code challenge")
+		return
+	}
+
+	idToken, err := m.IssueIdToken(grant.clientId, grant.username, grant.nonce)
+	if err != nil {
+		writeJson(w, http.StatusInternalServerError, map[string]string{"error": "server_error", "error_description": err.Error()})
+		return
+	}
+	writeJson(w, http.StatusOK, map[string]interface{}{
+		"access_token": RandomToken(),
+		"token_type":   "Bearer",
+		"expires_in":   int(mockIdTokenTTL.Seconds()),
+		"id_token":     idToken,
+	})
+}
+
+// IssueIdToken returns a signed id token for the username, as if they had signed in to the client.
+func (m *MockProvider) IssueIdToken(clientId, username, nonce string) (string, error) {
+	now := time.Now()
+	claims, err := json.Marshal(Claims{
+		Issuer:            m.issuer,
+		Subject:           username,
+		Audience:          Audience{clientId},
+		ExpiresAt:         now.Add(mockIdTokenTTL).Unix(),
+		IssuedAt:          now.Unix(),
+		Nonce:             nonce,
+		Name:              username,
+		PreferredUsername: username,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode id token claims: %w", err)
+	}
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT", "kid": m.keyId})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode id token header: %w", err)
+	}
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, m.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("failed to sign id token: %w", err)
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
@@ -0,0 +1,371 @@
+// Package oidc is a minimal OpenID Connect relying party for the authorization code flow with PKCE, along with a mock
+// provider for development and tests. Only RS256 signed id tokens are supported, which every provider must support.
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrInvalidToken is returned for an id token that is malformed, has an invalid signature, was not issued for the
+// client, or has expired.
+var ErrInvalidToken = errors.New("the id token is invalid or has expired")
+
+// clockSkew is how far the clocks of the provider and the relying party may drift apart.
+const clockSkew = time.Minute
+
+// jwksRefreshInterval limits how often the keys of a provider are fetched again when a token uses an unknown key id.
+const jwksRefreshInterval = time.Minute
+
+// Metadata is the subset of the discovery document of a provider that is used by the relying party.
+type Metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JwksUri               string `json:"jwks_uri"`
+}
+
+// Audience is the aud claim of an id token, which is either a single string or a list of strings.
+type Audience []string
+
+func (a *Audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = Audience{single}
+		return nil
+	}
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return fmt.Errorf("aud must be a string or a list of strings: %w", err)
+	}
+	*a = multiple
+	return nil
+}
+
+func (a Audience) MarshalJSON() ([]byte, error) {
+	if len(a) == 1 {
+		return json.Marshal(a[0])
+	}
+	return json.Marshal([]string(a))
+}
+
+// Claims are the claims of an id token that are used by the relying party.
+type Claims struct {
+	Issuer            string   `json:"iss"`
+	Subject           string   `json:"sub"`
+	Audience          Audience `json:"aud"`
+	ExpiresAt         int64    `json:"exp"`
+	IssuedAt          int64    `json:"iat"`
+	Nonce             string   `json:"nonce,omitempty"`
+	Name              string   `json:"name,omitempty"`
+	PreferredUsername string   `json:"preferred_username,omitempty"`
+	Email             string   `json:"email,omitempty"`
+}
+
+// DisplayName returns the most readable name of the end user that the claims contain.
+func (c *Claims) DisplayName() string {
+	for _, name := range []string{c.Name, c.PreferredUsername, c.Email} {
+		if name != "" {
+			return name
+		}
+	}
+	return c.Subject
+}
+
+// Provider discovers the endpoints and keys of an OpenID Connect provider and verifies the id tokens that it issues.
+// The discovery document and keys are fetched on first use, so that the provider does not need to be reachable when
+// the relying party starts.
+type Provider struct {
+	issuer string
+	client *http.Client
+
+	lock          sync.Mutex
+	metadata      *Metadata
+	keys          map[string]*rsa.PublicKey
+	keysFetchedAt time.Time
+}
+
+// NewProvider returns a provider for the issuer url, the discovery document is expected at
+// <issuer>/.well-known/openid-configuration.
+func NewProvider(issuer string, client *http.Client) *Provider {
+	if client == nil {
+		client = &http.Client{Timeout: time.Second * 10}
+	}
+	return &Provider{issuer: strings.TrimSuffix(issuer, "/"), client: client}
+}
+
+func (p *Provider) Issuer() string {
+	return p.issuer
+}
+
+func (p *Provider) getJson(ctx context.Context, u string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	res, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to get '%s': %w", u, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to get '%s': status %d", u, res.StatusCode)
+	}
+	if err := json.NewDecoder(io.LimitReader(res.Body, 1<<20)).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode '%s': %w", u, err)
+	}
+	return nil
+}
+
+// Metadata returns the discovery document of the provider.
+func (p *Provider) Metadata(ctx context.Context) (*Metadata, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.metadata != nil {
+		return p.metadata, nil
+	}
+	var out Metadata
+	if err := p.getJson(ctx, p.issuer+"/.well-known/openid-configuration", &out); err != nil {
+		return nil, err
+	}
+	if out.Issuer != p.issuer {
+		return nil, fmt.Errorf("the discovery document is for issuer '%s' rather than '%s'", out.Issuer, p.issuer)
+	} else if out.AuthorizationEndpoint == "" || out.TokenEndpoint == "" || out.JwksUri == "" {
+		return nil, fmt.Errorf("the discovery document of '%s' is missing endpoints", p.issuer)
+	}
+	p.metadata = &out
+	return p.metadata, nil
+}
+
+// jsonWebKey is a public key from the jwks of a provider, only the RSA parameters are used.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+func (k *jsonWebKey) publicKey() (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, err
+	}
+	exponent := new(big.Int).SetBytes(e)
+	if !exponent.IsInt64() || exponent.Int64() < 3 || exponent.Int64() > 1<<31-1 {
+		return nil, fmt.Errorf("invalid exponent")
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+}
+
+// publicKey returns the signing key with the key id, fetching the keys of the provider again when it is not known.
+func (p *Provider) publicKey(ctx context.Context, keyId string) (*rsa.PublicKey, error) {
+	metadata, err := p.Metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if key, ok := p.keys[keyId]; ok {
+		return key, nil
+	} else if time.Since(p.keysFetchedAt) < jwksRefreshInterval {
+		return nil, fmt.Errorf("%w: unknown key id '%s'", ErrInvalidToken, keyId)
+	}
+	var set jsonWebKeySet
+	if err := p.getJson(ctx, metadata.JwksUri, &set); err != nil {
+		return nil, err
+	}
+	p.keys = make(map[string]*rsa.PublicKey, len(set.Keys))
+	p.keysFetchedAt = time.Now()
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+		if key, err := k.publicKey(); err == nil {
+			p.keys[k.Kid] = key
+		}
+	}
+	if key, ok := p.keys[keyId]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("%w: unknown key id '%s'", ErrInvalidToken, keyId)
+}
+
+// Verify checks the signature, issuer, audience and expiry of the id token and returns its claims. Checking the nonce
+// is left to the caller since only the relying party that started the login knows it.
+func (p *Provider) Verify(ctx context.Context, rawToken string, audience string) (*Claims, error) {
+	parts := strings.Split(rawToken, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: malformed token", ErrInvalidToken)
+	}
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if raw, err := base64.RawURLEncoding.DecodeString(parts[0]); err != nil {
+		return nil, fmt.Errorf("%w: malformed header", ErrInvalidToken)
+	} else if err := json.Unmarshal(raw, &header); err != nil {
+		return nil, fmt.Errorf("%w: malformed header", ErrInvalidToken)
+	} else if header.Alg != "RS256" {
+		return nil, fmt.Errorf("%w: unsupported algorithm '%s'", ErrInvalidToken, header.Alg)
+	}
+	key, err := p.publicKey(ctx, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed signature", ErrInvalidToken)
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+		return nil, fmt.Errorf("%w: invalid signature", ErrInvalidToken)
+	}
+	var claims Claims
+	if raw, err := base64.RawURLEncoding.DecodeString(parts[1]); err != nil {
+		return nil, fmt.Errorf("%w: malformed claims", ErrInvalidToken)
+	} else if err := json.Unmarshal(raw, &claims); err != nil {
+		return nil, fmt.Errorf("%w: malformed claims", ErrInvalidToken)
+	}
+	now := time.Now()
+	switch {
+	case claims.Issuer != p.issuer:
+		return nil, fmt.Errorf("%w: unexpected issuer '%s'", ErrInvalidToken, claims.Issuer)
+	case !slices.Contains(claims.Audience, audience):
+		return nil, fmt.Errorf("%w: not issued for '%s'", ErrInvalidToken, audience)
+	case claims.Subject == "":
+		return nil, fmt.Errorf("%w: missing subject", ErrInvalidToken)
+	case now.Add(-clockSkew).Unix() >= claims.ExpiresAt:
+		return nil, fmt.Errorf("%w: expired", ErrInvalidToken)
+	case claims.IssuedAt > now.Add(clockSkew).Unix():
+		return nil, fmt.Errorf("%w: issued in the future", ErrInvalidToken)
+	}
+	return &claims, nil
+}
+
+// Client is a relying party that signs users in with the authorization code flow of a provider.
+type Client struct {
+	Provider     *Provider
+	ClientId     string
+	ClientSecret string
+	// RedirectUrl is the callback of the relying party that the provider redirects back to with the code.
+	RedirectUrl string
+	// Scopes defaults to openid, profile and email.
+	Scopes []string
+}
+
+// RandomToken generates a random value for the state, nonce or PKCE code verifier of a login.
+func RandomToken() string {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// CodeChallenge returns the S256 PKCE code challenge of the code verifier.
+func CodeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// AuthCodeUrl returns the url that the user is sent to in order to sign in. The state, nonce and verifier must be
+// kept by the relying party until the user returns to the redirect url.
+func (c *Client) AuthCodeUrl(ctx context.Context, state, nonce, verifier string) (string, error) {
+	metadata, err := c.Provider.Metadata(ctx)
+	if err != nil {
+		return "", err
+	}
+	scopes := c.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{"openid", "profile", "email"}
+	}
+	query := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {c.ClientId},
+		"redirect_uri":          {c.RedirectUrl},
+		"scope":                 {strings.Join(scopes, " ")},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {CodeChallenge(verifier)},
+		"code_challenge_method": {"S256"},
+	}
+	separator := "?"
+	if strings.Contains(metadata.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+	return metadata.AuthorizationEndpoint + separator + query.Encode(), nil
+}
+
+// Exchange redeems the code that the provider redirected back with and returns the verified id token and its claims.
+func (c *Client) Exchange(ctx context.Context, code, verifier, nonce string) (string, *Claims, error) {
+	metadata, err := c.Provider.Metadata(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {c.RedirectUrl},
+		"client_id":     {c.ClientId},
+		"code_verifier": {verifier},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, metadata.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if c.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(c.ClientId), url.QueryEscape(c.ClientSecret))
+	}
+	res, err := c.Provider.client.Do(req)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to exchange code: %w", err)
+	}
+	defer res.Body.Close()
+	var body struct {
+		IdToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.NewDecoder(io.LimitReader(res.Body, 1<<20)).Decode(&body); err != nil {
+		return "", nil, fmt.Errorf("failed to decode token response: status %d: %w", res.StatusCode, err)
+	} else if res.StatusCode != http.StatusOK || body.Error != "" {
+		return "", nil, fmt.Errorf("failed to exchange code: status %d: %s %s", res.StatusCode, body.Error, body.ErrorDescription)
+	} else if body.IdToken == "" {
+		return "", nil, fmt.Errorf("the token response has no id token")
+	}
+	claims, err := c.Provider.Verify(ctx, body.IdToken, c.ClientId)
+	if err != nil {
+		return "", nil, err
+	}
+	if claims.Nonce != nonce {
+		return "", nil, fmt.Errorf("%w: unexpected nonce", ErrInvalidToken)
+	}
+	return body.IdToken, claims, nil
+}
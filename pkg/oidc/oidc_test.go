@@ -0,0 +1,99 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func newMockServer(t *testing.T) (*MockProvider, *httptest.Server) {
+	var mock *MockProvider
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mock.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	var err error
+	if mock, err = NewMockProvider(server.URL); err != nil {
+		t.Fatal(err)
+	}
+	return mock, server
+}
+
+// login follows the authorization url with a login hint and returns the code and state of the redirect.
+func login(t *testing.T, authUrl string, username string) (string, string) {
+	noRedirects := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	res, err := noRedirects.Get(authUrl + "&login_hint=" + url.QueryEscape(username))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = res.Body.Close()
+	if res.StatusCode != http.StatusSeeOther {
+		t.Fatalf("expected a redirect, got %d", res.StatusCode)
+	}
+	location, err := url.Parse(res.Header.Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return location.Query().Get("code"), location.Query().Get("state")
+}
+
+func TestAuthorizationCodeFlowWithMockProvider(t *testing.T) {
+	ctx := context.Background()
+	_, server := newMockServer(t)
+	client := &Client{Provider: NewProvider(server.URL, nil), ClientId: "todo-app", ClientSecret: "secret", RedirectUrl: "http://localhost/callback"}
+
+	state, nonce, verifier := RandomToken(), RandomToken(), RandomToken()
+	authUrl, err := client.AuthCodeUrl(ctx, state, nonce, verifier)
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, gotState := login(t, authUrl, "alice")
+	if gotState != state {
+		t.Fatalf("expected state %q, got %q", state, gotState)
+	}
+	idToken, claims, err := client.Exchange(ctx, code, verifier, nonce)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if claims.Subject != "alice" || claims.DisplayName() != "alice" || claims.Issuer != server.URL {
+		t.Errorf("unexpected claims %+v", claims)
+	}
+	// the code can only be exchanged once
+	if _, _, err := client.Exchange(ctx, code, verifier, nonce); err == nil {
+		t.Error("expected the code to be rejected the second time")
+	}
+
+	if _, err := client.Provider.Verify(ctx, idToken, "another-client"); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("expected the token to be rejected for another audience, got %v", err)
+	}
+	if _, err := client.Provider.Verify(ctx, idToken[:len(idToken)-4]+"AAAA", "todo-app"); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("expected the token to be rejected with a modified signature, got %v", err)
+	}
+
+	code, _ = login(t, authUrl, "bob")
+	if _, _, err := client.Exchange(ctx, code, RandomToken(), nonce); err == nil {
+		t.Error("expected the code to be rejected with another code verifier")
+	}
+	code, _ = login(t, authUrl, "bob")
+	if _, _, err := client.Exchange(ctx, code, verifier, RandomToken()); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("expected the token to be rejected with another nonce, got %v", err)
+	}
+}
+
+func TestVerifyRejectsTokensOfAnotherIssuer(t *testing.T) {
+	ctx := context.Background()
+	_, server := newMockServer(t)
+	other, _ := newMockServer(t)
+	idToken, err := other.IssueIdToken("todo-app", "alice", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewProvider(server.URL, nil).Verify(ctx, idToken, "todo-app"); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("expected the token of another issuer to be rejected, got %v", err)
+	}
+}